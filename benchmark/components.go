@@ -10,3 +10,32 @@ type componentWithValue struct {
 	ecs.Component
 	value int
 }
+
+type component1 struct{ ecs.Component }
+type component2 struct{ ecs.Component }
+type component3 struct{ ecs.Component }
+type component4 struct{ ecs.Component }
+type component5 struct{ ecs.Component }
+type component6 struct{ ecs.Component }
+type component7 struct{ ecs.Component }
+type component8 struct{ ecs.Component }
+type component9 struct{ ecs.Component }
+type component10 struct{ ecs.Component }
+type component11 struct{ ecs.Component }
+type component12 struct{ ecs.Component }
+type component13 struct{ ecs.Component }
+type component14 struct{ ecs.Component }
+type component15 struct{ ecs.Component }
+type component16 struct{ ecs.Component }
+
+// manyComponents returns the first n of 16 different components. Useful for benchmarking entities with many components.
+func manyComponents(n int) []ecs.IComponent {
+	components := []ecs.IComponent{
+		&component1{}, &component2{}, &component3{}, &component4{},
+		&component5{}, &component6{}, &component7{}, &component8{},
+		&component9{}, &component10{}, &component11{}, &component12{},
+		&component13{}, &component14{}, &component15{}, &component16{},
+	}
+
+	return components[:n]
+}
@@ -141,6 +141,39 @@ func BenchmarkRemove(b *testing.B) {
 	}
 }
 
+// BenchmarkArchetypeMove measures moving an entity back and forth between two archetypes by inserting and removing a
+// single component, for entities with a varying number of components. Cached archetype edges make the cost of such
+// moves independent of the number of components that need to be hashed.
+func BenchmarkArchetypeMove(b *testing.B) {
+	for _, numberOfComponents := range []int{1, 4, 10, 16} {
+		b.Run(fmt.Sprintf("InsertRemove-Components-%d", numberOfComponents), func(b *testing.B) {
+			world := ecs.NewDefaultWorld()
+			entity, err := ecs.Spawn(&world, manyComponents(numberOfComponents)...)
+			if err != nil {
+				b.FailNow()
+			}
+
+			for b.Loop() {
+				ecs.Insert(&world, entity, &emptyComponentA{})
+				ecs.Remove1[emptyComponentA](&world, entity)
+			}
+		})
+
+		b.Run(fmt.Sprintf("InsertRemoveTwo-Components-%d", numberOfComponents), func(b *testing.B) {
+			world := ecs.NewDefaultWorld()
+			entity, err := ecs.Spawn(&world, manyComponents(numberOfComponents)...)
+			if err != nil {
+				b.FailNow()
+			}
+
+			for b.Loop() {
+				ecs.Insert(&world, entity, &emptyComponentA{}, &emptyComponentB{})
+				ecs.Remove2[emptyComponentA, emptyComponentB](&world, entity)
+			}
+		})
+	}
+}
+
 func BenchmarkDelete(b *testing.B) {
 	world := ecs.NewDefaultWorld()
 	if err := fillWorld(&world); err != nil {
@@ -0,0 +1,50 @@
+// Demonstrate how to make structural changes to the world from inside a system using Commands.
+package main
+
+import (
+	"fmt"
+
+	"github.com/lucdrenth/murphecs/examples/app/run"
+	"github.com/lucdrenth/murphecs/src/app"
+	"github.com/lucdrenth/murphecs/src/ecs"
+)
+
+const update app.Schedule = "Update"
+
+type health struct {
+	ecs.Component
+	value int
+}
+
+func main() {
+	var logger app.Logger = &app.SimpleConsoleLogger{}
+	myApp, err := app.New(logger, ecs.DefaultWorldConfigs())
+	if err != nil {
+		panic(err)
+	}
+
+	myApp.AddSchedule(update, app.ScheduleTypeRepeating)
+
+	myApp.AddResource(&logger)
+	myApp.AddSystem(update, spawnEnemy)
+	myApp.AddSystem(update, damageEnemies)
+
+	run.RunApp(&myApp)
+}
+
+func spawnEnemy(commands *app.Commands) {
+	commands.Spawn(&health{value: 25})
+}
+
+// Deleting an entity while ranging over query results would move the components that the query results point
+// to. Commands are applied after all systems of the schedule have run, so this is safe.
+func damageEnemies(query *ecs.Query1[health, ecs.Default], commands *app.Commands, log app.Logger) error {
+	return query.Result().Iter(func(entity ecs.EntityId, health *health) error {
+		health.value -= 10
+		if health.value <= 0 {
+			log.Info(fmt.Sprintf("enemy %v died", entity))
+			commands.Delete(entity)
+		}
+		return nil
+	})
+}
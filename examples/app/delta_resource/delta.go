@@ -1,4 +1,4 @@
-// Demonstrate how to set a delta resource that can be used in systems
+// Demonstrate how to use the Time resource to get the delta time in systems
 package main
 
 import (
@@ -9,12 +9,7 @@ import (
 	"github.com/lucdrenth/murphecs/src/ecs"
 )
 
-const beforeUpdate app.Schedule = "BeforeUpdate" // this schedule is where the new delta resource is set.
-const update app.Schedule = "Update"             // this schedule can use the updated delta resource
-
-type delta struct {
-	secs float64
-}
+const update app.Schedule = "Update"
 
 func main() {
 	var logger app.Logger = &app.SimpleConsoleLogger{}
@@ -23,23 +18,22 @@ func main() {
 		panic(err)
 	}
 
-	myApp.AddSchedule(beforeUpdate, app.ScheduleTypeRepeating)
 	myApp.AddSchedule(update, app.ScheduleTypeRepeating)
 
 	myApp.AddResource(&logger)
-	myApp.AddResource(&delta{})
-	myApp.AddSystem(beforeUpdate, createSetDeltaResourceSystem(&myApp))
 	myApp.AddSystem(update, logCurrentDelta)
+	myApp.AddSystem(update, slowDownTime)
 
 	run.RunApp(&myApp)
 }
 
-func createSetDeltaResourceSystem(app *app.SubApp) func(delta *delta) {
-	return func(delta *delta) {
-		delta.secs = app.Delta()
-	}
+func logCurrentDelta(log app.Logger, time app.Time) {
+	log.Info(fmt.Sprintf("tick %d - delta: %f - elapsed: %s", time.Ticks(), time.DeltaSeconds(), time.Elapsed()))
 }
 
-func logCurrentDelta(log app.Logger, delta *delta) {
-	log.Info(fmt.Sprintf("delta: %f", delta.secs))
+// slowDownTime makes time pass half as fast after 100 ticks.
+func slowDownTime(time *app.Time) {
+	if time.Ticks() == 100 {
+		time.SetScale(0.5)
+	}
 }
@@ -0,0 +1,45 @@
+// Demonstrate how to send and read events between systems.
+package main
+
+import (
+	"fmt"
+
+	"github.com/lucdrenth/murphecs/examples/app/run"
+	"github.com/lucdrenth/murphecs/src/app"
+	"github.com/lucdrenth/murphecs/src/ecs"
+)
+
+const update app.Schedule = "Update"
+
+type damage struct {
+	amount int
+}
+
+func main() {
+	var logger app.Logger = &app.SimpleConsoleLogger{}
+	myApp, err := app.New(logger, ecs.DefaultWorldConfigs())
+	if err != nil {
+		panic(err)
+	}
+
+	myApp.AddSchedule(update, app.ScheduleTypeRepeating)
+
+	myApp.AddResource(&logger)
+	myApp.AddSystem(update, dealDamage)
+	myApp.AddSystem(update, logDamage)
+
+	run.RunApp(&myApp)
+}
+
+// Events are sent with an EventWriter. The events resource is created automatically.
+func dealDamage(writer *app.EventWriter[damage]) {
+	writer.Send(damage{amount: 10})
+}
+
+// Every EventReader sees each event exactly once. Events are kept for the tick they
+// were sent in and the tick after, and are dropped after that.
+func logDamage(reader *app.EventReader[damage], log app.Logger) {
+	for event := range reader.Read() {
+		log.Info(fmt.Sprintf("received %d damage", event.amount))
+	}
+}
@@ -5,6 +5,8 @@
 //  1. They encapsulate resources and systems in to 1 pluggable Feature that can easily be replaced.
 //  2. In contrast to adding systems directly to an app, a resource used as a system param does not need
 //     to be added before adding a system that uses that resource.
+//  3. Features are deduplicated by type and added in the order of their dependencies, so shared features
+//     can safely be nested by multiple other features.
 package main
 
 import (
@@ -37,6 +39,9 @@ func main() {
 		AppName: "MyApp",
 	})
 
+	// tickCounterFeature is already added as a nested feature of debugPrinterFeature, so this does nothing.
+	myApp.AddFeature(&tickCounterFeature{})
+
 	run.RunApp(&myApp)
 }
 
@@ -50,18 +55,37 @@ type debugPrinterFeature struct {
 	AppName string
 }
 
-type tickCounter struct {
-	count int
-}
-
 func (f *debugPrinterFeature) Init() {
 	f.AddResource(&appNameResource{name: f.AppName})
-	f.AddResource(&tickCounter{})
+	f.AddFeature(&tickCounterFeature{})
 	f.AddSystem(startup, startupPrinter)
 	f.AddSystem(update, tickPrinter)
 	f.AddSystem(cleanup, cleanupPrinter)
 }
 
+// Finish is called once all features are added, right before the app runs. Returning an error stops the app
+// from running.
+func (f *debugPrinterFeature) Finish(myApp *app.SubApp) error {
+	if myApp.NumberOfSystems() == 0 {
+		return fmt.Errorf("no systems were added")
+	}
+
+	return nil
+}
+
+// A feature that is shared by other features.
+type tickCounterFeature struct {
+	app.Feature
+}
+
+type tickCounter struct {
+	count int
+}
+
+func (f *tickCounterFeature) Init() {
+	f.AddResource(&tickCounter{})
+}
+
 func startupPrinter(logger app.Logger, appName appNameResource) {
 	logger.Info(fmt.Sprintf("%s - Starting up", appName.name))
 }
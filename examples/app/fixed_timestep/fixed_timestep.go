@@ -0,0 +1,51 @@
+// Demonstrate how to run the repeated schedules at a fixed timestep, and how to interpolate between ticks in a
+// frame schedule.
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lucdrenth/murphecs/examples/app/run"
+	"github.com/lucdrenth/murphecs/src/app"
+	"github.com/lucdrenth/murphecs/src/ecs"
+)
+
+const fixedUpdate app.Schedule = "FixedUpdate"
+const render app.Schedule = "Render"
+
+type position struct {
+	previous float64
+	current  float64
+}
+
+func main() {
+	var logger app.Logger = &app.SimpleConsoleLogger{}
+	myApp, err := app.New(logger, ecs.DefaultWorldConfigs())
+	if err != nil {
+		panic(err)
+	}
+
+	// Simulate 10 ticks per second, render 30 frames per second and catch up on at most 5 ticks per frame.
+	myApp.SetTickRate(time.Second / 10)
+	myApp.SetFixedTimestepRunner(time.Second/30, 5)
+
+	myApp.AddSchedule(fixedUpdate, app.ScheduleTypeRepeating)
+	myApp.AddSchedule(render, app.ScheduleTypeFrame)
+	myApp.AddResource(&logger)
+	myApp.AddResource(&position{})
+	myApp.AddSystem(fixedUpdate, move)
+	myApp.AddSystem(render, draw)
+
+	run.RunApp(&myApp)
+}
+
+func move(position *position) {
+	position.previous = position.current
+	position.current += 1
+}
+
+func draw(log app.Logger, position position, timestep app.FixedTimestep) {
+	interpolated := position.previous + (position.current-position.previous)*timestep.Alpha()
+	log.Info(fmt.Sprintf("position: %.2f", interpolated))
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/lucdrenth/murphecs/examples/app/run"
 	"github.com/lucdrenth/murphecs/src/app"
 	"github.com/lucdrenth/murphecs/src/ecs"
 )
@@ -35,7 +34,9 @@ func (targetWorldAppFoo) GetCombinedQueryOptions(world *ecs.World) (ecs.Combined
 func main() {
 	var logger app.Logger = &app.SimpleConsoleLogger{}
 
-	appFoo, err := app.New(logger, ecs.DefaultWorldConfigs())
+	appFooConfigs := ecs.DefaultWorldConfigs()
+	appFooConfigs.Id = &appFooId
+	appFoo, err := app.New(logger, appFooConfigs)
 	if err != nil {
 		panic(err)
 	}
@@ -52,8 +53,10 @@ func main() {
 	appBar.AddSchedule(startup, app.ScheduleTypeStartup)
 	appBar.AddSchedule(update, app.ScheduleTypeRepeating)
 
-	// 3. Register appFoo to appBar so that we can query appFoo from a appBar system
-	appBar.RegisterOuterWorld(appFooId, appFoo.World())
+	// 3. Add both apps to an App. Because the world of appFoo has an id, it is registered to appBar so that we can
+	// query appFoo from a appBar system. This must be done before adding the system.
+	myApp := app.NewApp(logger)
+	myApp.AddSubApp("Foo", &appFoo).AddSubApp("Bar", &appBar)
 
 	// 4. We register a startup system for appFoo that spawns components that appBar will query
 	appFoo.AddSystem(startup, func(world *ecs.World, log app.Logger) error {
@@ -61,13 +64,13 @@ func main() {
 		if err != nil {
 			return err
 		}
-		log.Info(fmt.Sprintf("spawned entity %d with value 100", entity))
+		log.Info(fmt.Sprintf("spawned entity %v with value 100", entity))
 
 		entity, err = ecs.Spawn(world, &myComponent{value: 200})
 		if err != nil {
 			return err
 		}
-		log.Info(fmt.Sprintf("spawned entity %d with value 200", entity))
+		log.Info(fmt.Sprintf("spawned entity %v with value 200", entity))
 
 		return nil
 	})
@@ -75,7 +78,7 @@ func main() {
 	// 5. Now register a system for appBar that queries appFoo
 	appBar.AddSystem(update, func(query *ecs.Query1[myComponent, targetWorldAppFoo], log app.Logger) error {
 		query.Result().Iter(func(entityId ecs.EntityId, a *myComponent) error {
-			log.Info(fmt.Sprintf("%d: %d", entityId, a.value))
+			log.Info(fmt.Sprintf("%v: %d", entityId, a.value))
 			return nil
 		})
 
@@ -83,5 +86,8 @@ func main() {
 		return nil
 	})
 
-	run.RunApp(&appFoo, &appBar)
+	// 6. Run both apps until ctrl+c is pressed
+	if err := myApp.Run(); err != nil {
+		logger.Error(err.Error())
+	}
 }
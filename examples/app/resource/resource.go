@@ -29,6 +29,8 @@ func main() {
 	myApp.AddResource(&counter{})
 	myApp.AddSystem(update, incrementCounter)
 	myApp.AddSystem(update, logCounter)
+	myApp.AddSystem(update, logCounterWithoutCopy)
+	myApp.AddSystem(update, resetCounter)
 
 	run.RunApp(&myApp)
 }
@@ -49,3 +51,16 @@ func incrementCounter(counter *counter) {
 func logCounter(counter counter, log app.Logger) {
 	log.Info(fmt.Sprintf("counter value: %d", counter.value))
 }
+
+// Res gives read-only access to the resource without copying it, which is useful for big resources. Like using a
+// resource by value, this allows the system to be ran in parallel with other systems that only read the resource.
+func logCounterWithoutCopy(counter *app.Res[counter], log app.Logger) {
+	log.Info(fmt.Sprintf("counter value without copy: %d", counter.Get().value))
+}
+
+// ResMut gives mutable access to the resource, just like using a pointer to the resource does.
+func resetCounter(counter *app.ResMut[counter]) {
+	if counter.Get().value >= 10 {
+		counter.Get().value = 0
+	}
+}
@@ -0,0 +1,55 @@
+// Demonstrate how to only run systems, or all systems of a schedule, when a condition is met.
+package main
+
+import (
+	"github.com/lucdrenth/murphecs/examples/app/run"
+	"github.com/lucdrenth/murphecs/src/app"
+	"github.com/lucdrenth/murphecs/src/ecs"
+)
+
+const update app.Schedule = "Update"
+const render app.Schedule = "Render"
+
+type gameState struct {
+	tick   int
+	paused bool
+}
+
+func main() {
+	var logger app.Logger = &app.SimpleConsoleLogger{}
+	myApp, err := app.New(logger, ecs.DefaultWorldConfigs())
+	if err != nil {
+		panic(err)
+	}
+
+	myApp.AddSchedule(update, app.ScheduleTypeRepeating)
+	myApp.AddSchedule(render, app.ScheduleTypeRepeating)
+	myApp.AddResource(&logger)
+	myApp.AddResource(&gameState{})
+
+	myApp.AddSystem(update, tick)
+	myApp.AddSystem(update, app.Configure(move).RunIf(isNotPaused))
+	myApp.AddSystem(render, draw)
+
+	// Only render on even ticks.
+	myApp.RunScheduleIf(render, func(state gameState) bool { return state.tick%2 == 0 })
+
+	run.RunApp(&myApp)
+}
+
+func isNotPaused(state gameState) bool {
+	return !state.paused
+}
+
+func tick(state *gameState) {
+	state.tick++
+	state.paused = state.tick%5 == 0
+}
+
+func move(log app.Logger, state gameState) {
+	log.Info("move")
+}
+
+func draw(log app.Logger, state gameState) {
+	log.Info("draw")
+}
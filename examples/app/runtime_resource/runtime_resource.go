@@ -0,0 +1,70 @@
+// Demonstrate how to insert and remove resources while the app is running, by using Commands.
+package main
+
+import (
+	"fmt"
+
+	"github.com/lucdrenth/murphecs/examples/app/run"
+	"github.com/lucdrenth/murphecs/src/app"
+	"github.com/lucdrenth/murphecs/src/ecs"
+)
+
+const update app.Schedule = "Update"
+
+type progress struct {
+	levelsLoaded int
+}
+
+// level only exists while a level is loaded.
+type level struct {
+	number       int
+	ticksPlaying int
+}
+
+func main() {
+	var logger app.Logger = &app.SimpleConsoleLogger{}
+	myApp, err := app.New(logger, ecs.DefaultWorldConfigs())
+	if err != nil {
+		panic(err)
+	}
+
+	myApp.AddSchedule(update, app.ScheduleTypeRepeating)
+
+	myApp.AddResource(&logger)
+	myApp.AddResource(&progress{})
+	myApp.AddSystem(update, loadLevel)
+
+	// The level resource does not exist yet, so these systems can only be added because they use Option or OptionMut.
+	myApp.AddSystem(update, play)
+	myApp.AddSystem(update, unloadLevel)
+
+	run.RunApp(&myApp)
+}
+
+// Resources that are inserted with commands are available from the next time the systems run.
+func loadLevel(current *app.Option[level], progress *progress, commands *app.Commands, log app.Logger) {
+	if current.IsPresent() {
+		return
+	}
+
+	progress.levelsLoaded++
+	log.Info(fmt.Sprintf("loading level %d", progress.levelsLoaded))
+	commands.InsertResource(&level{number: progress.levelsLoaded})
+}
+
+func unloadLevel(current *app.Option[level], commands *app.Commands, log app.Logger) {
+	loaded, ok := current.Get()
+	if !ok || loaded.ticksPlaying < 3 {
+		return
+	}
+
+	log.Info(fmt.Sprintf("unloading level %d", loaded.number))
+	app.CommandsRemoveResource[level](commands)
+}
+
+func play(current *app.OptionMut[level], log app.Logger) {
+	if loaded, ok := current.Get(); ok {
+		loaded.ticksPlaying++
+		log.Info(fmt.Sprintf("playing level %d", loaded.number))
+	}
+}
@@ -0,0 +1,40 @@
+// Demonstrate how to use states to run systems when entering, exiting or being in a state.
+package main
+
+import (
+	"github.com/lucdrenth/murphecs/examples/app/run"
+	"github.com/lucdrenth/murphecs/src/app"
+	"github.com/lucdrenth/murphecs/src/ecs"
+)
+
+type gameState int
+
+const (
+	mainMenu gameState = iota
+	loading
+	playing
+)
+
+func main() {
+	var logger app.Logger = &app.SimpleConsoleLogger{}
+	myApp, err := app.New(logger, ecs.DefaultWorldConfigs())
+	if err != nil {
+		panic(err)
+	}
+
+	myApp.AddResource(&logger)
+	app.AddState(&myApp, mainMenu)
+
+	myApp.AddSystem(app.OnEnter(mainMenu), func(log app.Logger) { log.Info("showing main menu") })
+	myApp.AddSystem(app.InState(mainMenu), func(next *app.NextState[gameState]) { next.Set(loading) })
+	myApp.AddSystem(app.OnExit(mainMenu), func(log app.Logger) { log.Info("hiding main menu") })
+
+	myApp.AddSystem(app.OnEnter(loading), func(log app.Logger, next *app.NextState[gameState]) {
+		log.Info("loading")
+		next.Set(playing)
+	})
+
+	myApp.AddSystem(app.OnEnter(playing), func(log app.Logger) { log.Info("started playing") })
+
+	run.RunApp(&myApp)
+}
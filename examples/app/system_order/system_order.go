@@ -0,0 +1,40 @@
+// Demonstrate how to control the order in which systems run by using labels and Before/After constraints.
+package main
+
+import (
+	"github.com/lucdrenth/murphecs/examples/app/run"
+	"github.com/lucdrenth/murphecs/src/app"
+	"github.com/lucdrenth/murphecs/src/ecs"
+)
+
+const update app.Schedule = "Update"
+
+func main() {
+	var logger app.Logger = &app.SimpleConsoleLogger{}
+	myApp, err := app.New(logger, ecs.DefaultWorldConfigs())
+	if err != nil {
+		panic(err)
+	}
+
+	myApp.AddSchedule(update, app.ScheduleTypeRepeating)
+	myApp.AddResource(&logger)
+
+	// Systems are added in the wrong order, but the constraints make them run as input -> move -> render.
+	myApp.AddSystem(update, app.Configure(render).After("move"))
+	myApp.AddSystem(update, app.Configure(move).Label("move"))
+	myApp.AddSystem(update, app.Configure(input).Before("move"))
+
+	run.RunApp(&myApp)
+}
+
+func input(log app.Logger) {
+	log.Info("input")
+}
+
+func move(log app.Logger) {
+	log.Info("move")
+}
+
+func render(log app.Logger) {
+	log.Info("render")
+}
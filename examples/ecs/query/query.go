@@ -29,7 +29,7 @@ func main() {
 	query.Prepare(&world)
 	query.Exec(&world)
 	query.Result().Iter(func(entityId ecs.EntityId, npc *NPC) error {
-		fmt.Printf("simple query: %d: %s \n", entityId, npc.name)
+		fmt.Printf("simple query: %v: %s \n", entityId, npc.name)
 		return nil
 	})
 
@@ -38,7 +38,7 @@ func main() {
 	query2.Prepare(&world)
 	query2.Exec(&world)
 	query2.Result().Iter(func(entityId ecs.EntityId, npc *NPC) error {
-		fmt.Printf("query with Friendly: %d: %s \n", entityId, npc.name)
+		fmt.Printf("query with Friendly: %v: %s \n", entityId, npc.name)
 		return nil
 	})
 
@@ -47,7 +47,7 @@ func main() {
 	query3.Prepare(&world)
 	query3.Exec(&world)
 	query3.Result().Iter(func(entityId ecs.EntityId, npc *NPC, dialog *Dialog) error {
-		fmt.Printf("query without Friendly: %d: %s says %s \n", entityId, npc.name, dialog.text)
+		fmt.Printf("query without Friendly: %v: %s says %s \n", entityId, npc.name, dialog.text)
 		return nil
 	})
 
@@ -0,0 +1,33 @@
+// Demonstrate how to create parent/child relationships between entities
+package main
+
+import (
+	"fmt"
+
+	"github.com/lucdrenth/murphecs/src/ecs"
+)
+
+type Inventory struct{ ecs.Component }
+type Item struct {
+	ecs.Component
+	name string
+}
+
+func main() {
+	world := ecs.NewDefaultWorld()
+	inventory, _ := ecs.Spawn(&world, &Inventory{})
+	sword, _ := ecs.Spawn(&world, &Item{name: "sword"})
+	shield, _ := ecs.Spawn(&world, &Item{name: "shield"})
+
+	ecs.SetParent(&world, sword, inventory)
+	ecs.SetParent(&world, shield, inventory)
+
+	for child := range ecs.IterChildren(&world, inventory) {
+		item, _ := ecs.Get1[Item](&world, child)
+		fmt.Printf("Inventory contains %s\n", item.name)
+	}
+
+	fmt.Printf("Before deleting: %d entities in the world\n", world.CountEntities())
+	ecs.DeleteRecursive(&world, inventory)
+	fmt.Printf("After deleting inventory recursively: %d entities in the world\n", world.CountEntities())
+}
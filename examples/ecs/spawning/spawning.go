@@ -31,5 +31,5 @@ func main() {
 		&NPC{name: "Murphy"},
 		&Health{max: 100, current: 80},
 	)
-	fmt.Printf("Spawned entity=%d, err=%v\n", entity, err)
+	fmt.Printf("Spawned entity=%v, err=%v\n", entity, err)
 }
@@ -0,0 +1,171 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// App runs multiple SubApps at the same time, each on their own goroutine, until one of them requests an exit with
+// the [Exit] resource or until the process receives SIGINT or SIGTERM.
+//
+//	myApp := app.NewApp(logger)
+//	myApp.AddSubApp("Game", &game).AddSubApp("Network", &network)
+//	err := myApp.Run()
+type App struct {
+	subApps         []*SubApp
+	logger          Logger
+	shutdownTimeout time.Duration
+	exitSignals     []os.Signal
+}
+
+// NewApp returns an App without any SubApps.
+func NewApp(logger Logger) App {
+	if logger == nil {
+		logger = &NoOpLogger{}
+	}
+
+	return App{
+		logger:          logger,
+		shutdownTimeout: 5 * time.Second,
+		exitSignals:     []os.Signal{syscall.SIGINT, syscall.SIGTERM},
+	}
+}
+
+// AddSubApp adds subApp with the given name, which must be unique.
+//
+// The worlds of all SubApps that have a world id are registered as outer world to each other, so that they can be
+// queried by the other SubApps. This must be done before adding the systems that query them.
+func (app *App) AddSubApp(name string, subApp *SubApp) *App {
+	for _, other := range app.subApps {
+		if other.Name() == name {
+			app.logger.Error(fmt.Sprintf("failed to add sub app %s: %v", name, ErrSubAppAlreadyPresent))
+			return app
+		}
+	}
+
+	subApp.SetName(name)
+
+	for _, other := range app.subApps {
+		registerOuterWorld(subApp, other)
+		registerOuterWorld(other, subApp)
+	}
+
+	app.subApps = append(app.subApps, subApp)
+	return app
+}
+
+// registerOuterWorld registers the world of outer to subApp if it has an id, and if the id is not registered yet.
+func registerOuterWorld(subApp *SubApp, outer *SubApp) {
+	id := outer.World().Id()
+	if id == nil {
+		return
+	}
+
+	if _, exists := subApp.outerWorlds[*id]; exists {
+		return
+	}
+
+	subApp.outerWorlds[*id] = outer.World()
+}
+
+// SetShutdownTimeout sets how long Run waits for the SubApps to stop after an exit was requested.
+func (app *App) SetShutdownTimeout(timeout time.Duration) {
+	app.shutdownTimeout = timeout
+}
+
+// Run runs all SubApps until one of them requests an exit, one of them stops by itself, or the process receives
+// SIGINT or SIGTERM. See RunContext.
+func (app *App) Run() error {
+	return app.RunContext(context.Background())
+}
+
+// RunContext runs all SubApps until ctx is done, one of them requests an exit, one of them stops by itself, or the
+// process receives SIGINT or SIGTERM. All SubApps are then asked to stop, which includes running their cleanup
+// schedules.
+//
+// Returns the errors that each SubApp returned or logged, and an ErrShutdownTimeout for each SubApp that did not
+// stop within the shutdown timeout.
+func (app *App) RunContext(ctx context.Context) error {
+	if len(app.subApps) == 0 {
+		return nil
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, app.exitSignals...)
+	defer signal.Stop(signals)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	isDoneChannels := make([]chan struct{}, len(app.subApps))
+	runErrors := make([]error, len(app.subApps)) // may only be read once the isDoneChannel of the SubApp is closed
+	anyIsDone := make(chan struct{}, len(app.subApps))
+	exitRequests := make(chan struct{}, len(app.subApps))
+
+	for i, subApp := range app.subApps {
+		isDoneChannels[i] = make(chan struct{})
+		go func() {
+			defer close(isDoneChannels[i])
+			runErrors[i] = subApp.RunContext(ctx)
+			anyIsDone <- struct{}{}
+		}()
+
+		// forward the exit request of the SubApp, so that all other SubApps stop as well
+		go func() {
+			select {
+			case <-subApp.exit.channel:
+				exitRequests <- struct{}{}
+			case <-ctx.Done():
+			}
+		}()
+	}
+
+	select {
+	case sig := <-signals:
+		app.logger.Info(fmt.Sprintf("received %s, stopping", sig))
+	case <-ctx.Done():
+	case <-exitRequests:
+	case <-anyIsDone:
+	}
+	cancel()
+
+	errs := []error{}
+	timeout := time.NewTimer(app.shutdownTimeout)
+	defer timeout.Stop()
+	hasTimedOut := false
+
+	for i, subApp := range app.subApps {
+		isDone := false
+		if hasTimedOut {
+			select {
+			case <-isDoneChannels[i]:
+				isDone = true
+			default:
+			}
+		} else {
+			select {
+			case <-isDoneChannels[i]:
+				isDone = true
+			case <-timeout.C:
+				hasTimedOut = true
+			}
+		}
+
+		if !isDone {
+			errs = append(errs, fmt.Errorf("%s: %w", subApp.Name(), ErrShutdownTimeout))
+		} else if runErrors[i] != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", subApp.Name(), runErrors[i]))
+		}
+
+		for _, err := range subApp.Errors() {
+			errs = append(errs, fmt.Errorf("%s: %w", subApp.Name(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
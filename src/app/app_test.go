@@ -0,0 +1,102 @@
+package app
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lucdrenth/murphecs/src/ecs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApp(t *testing.T) {
+	newSubApp := func(assert *assert.Assertions, worldId *ecs.WorldId) *SubApp {
+		worldConfigs := ecs.DefaultWorldConfigs()
+		worldConfigs.Id = worldId
+		subApp, err := New(nil, worldConfigs)
+		assert.NoError(err)
+		subApp.SetTickRate(time.Millisecond)
+		subApp.AddSchedule(testSchedule, ScheduleTypeRepeating)
+		return &subApp
+	}
+
+	t.Run("stops all sub apps when one of them requests an exit", func(t *testing.T) {
+		assert := assert.New(t)
+
+		const cleanupSchedule Schedule = "cleanup"
+		subAppA := newSubApp(assert, nil)
+		subAppA.AddSystem(testSchedule, func(exit *Exit) { exit.Request() })
+
+		isCleanedUp := false
+		subAppB := newSubApp(assert, nil)
+		subAppB.AddSchedule(cleanupSchedule, ScheduleTypeCleanup)
+		subAppB.AddSystem(cleanupSchedule, func() { isCleanedUp = true })
+
+		app := NewApp(nil)
+		app.AddSubApp("A", subAppA).AddSubApp("B", subAppB)
+
+		assert.NoError(app.Run())
+		assert.True(isCleanedUp)
+	})
+
+	t.Run("returns the errors of the sub apps", func(t *testing.T) {
+		assert := assert.New(t)
+
+		errSystem := errors.New("system failed")
+		subAppA := newSubApp(assert, nil)
+		subAppA.AddSystem(testSchedule, func(exit *Exit) error {
+			exit.Request()
+			return errSystem
+		})
+
+		app := NewApp(nil)
+		app.AddSubApp("A", subAppA)
+
+		err := app.Run()
+		assert.ErrorContains(err, "A: ")
+		assert.ErrorContains(err, errSystem.Error())
+	})
+
+	t.Run("returns an error for sub apps that do not shut down within the timeout", func(t *testing.T) {
+		assert := assert.New(t)
+
+		const cleanupSchedule Schedule = "cleanup"
+		subAppA := newSubApp(assert, nil)
+		subAppA.AddSystem(testSchedule, func(exit *Exit) { exit.Request() })
+		subAppA.AddSchedule(cleanupSchedule, ScheduleTypeCleanup)
+		subAppA.AddSystem(cleanupSchedule, func() { time.Sleep(100 * time.Millisecond) })
+
+		app := NewApp(nil)
+		app.AddSubApp("A", subAppA)
+		app.SetShutdownTimeout(time.Millisecond)
+
+		assert.ErrorIs(app.Run(), ErrShutdownTimeout)
+	})
+
+	t.Run("registers the worlds of sub apps as outer worlds of each other", func(t *testing.T) {
+		assert := assert.New(t)
+
+		worldIdA, worldIdB := ecs.WorldId(1), ecs.WorldId(2)
+		subAppA := newSubApp(assert, &worldIdA)
+		subAppB := newSubApp(assert, &worldIdB)
+		subAppC := newSubApp(assert, nil)
+
+		app := NewApp(nil)
+		app.AddSubApp("A", subAppA).AddSubApp("B", subAppB).AddSubApp("C", subAppC)
+
+		assert.Equal(map[ecs.WorldId]*ecs.World{worldIdB: subAppB.World()}, *subAppA.OuterWorlds())
+		assert.Equal(map[ecs.WorldId]*ecs.World{worldIdA: subAppA.World()}, *subAppB.OuterWorlds())
+		assert.Equal(map[ecs.WorldId]*ecs.World{worldIdA: subAppA.World(), worldIdB: subAppB.World()}, *subAppC.OuterWorlds())
+	})
+
+	t.Run("logs an error when adding a sub app with a name that is already used", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app := NewApp(&logger)
+		app.AddSubApp("A", newSubApp(assert, nil)).AddSubApp("A", newSubApp(assert, nil))
+
+		assert.Equal(uint(1), logger.err)
+		assert.Len(app.subApps, 1)
+	})
+}
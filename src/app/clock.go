@@ -0,0 +1,46 @@
+package app
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells the current time. It is used to measure the time between steps of an app.
+type Clock interface {
+	Now() time.Time
+}
+
+var _ Clock = realClock{}
+var _ Clock = (*VirtualClock)(nil)
+
+// realClock tells the actual time.
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// VirtualClock is a Clock of which the time only changes by calling Advance. It can be used to make time-based
+// logic deterministic, for example in tests.
+type VirtualClock struct {
+	mutex sync.Mutex
+	now   time.Time
+}
+
+// NewVirtualClock returns a VirtualClock that starts at start.
+func NewVirtualClock(start time.Time) *VirtualClock {
+	return &VirtualClock{now: start}
+}
+
+func (clock *VirtualClock) Now() time.Time {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	return clock.now
+}
+
+// Advance moves the time of the clock forward by duration.
+func (clock *VirtualClock) Advance(duration time.Duration) {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	clock.now = clock.now.Add(duration)
+}
@@ -0,0 +1,98 @@
+package app
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/lucdrenth/murphecs/src/ecs"
+)
+
+// Commands is a system param that records structural changes to the world, such as spawning and deleting
+// entities, and changes to the resources of the app. The recorded commands are applied after all systems of the
+// SystemSet have run, which makes it safe to use while ranging over query results. See [ecs.Commands].
+//
+// Must be used as a pointer: *Commands.
+type Commands struct {
+	ecs.Commands
+	resources *resourceStorage
+}
+
+func (commands *Commands) init(resources *resourceStorage) error {
+	commands.resources = resources
+	return nil
+}
+
+// InsertResource records adding resource, which must be passed by reference. Applying it fails if a resource of
+// the same type is already present.
+//
+// Systems that use the resource can be added while the command is not applied yet. They do not run until the
+// resource is present.
+func (commands *Commands) InsertResource(resource Resource) {
+	isPending := commands.addPendingResource(resource)
+	commands.Run(func(_ *ecs.World) error {
+		if isPending {
+			commands.resources.removePending(reflect.TypeOf(resource))
+		}
+
+		if err := commands.resources.add(resource); err != nil {
+			return fmt.Errorf("failed to insert resource %s: %w", getResourceDebugType(resource), err)
+		}
+
+		return nil
+	})
+}
+
+// InsertOrReplaceResource records adding resource, which must be passed by reference, or replacing the resource of
+// the same type if it is already present. Systems that use the resource get the new one from the next time they
+// run.
+func (commands *Commands) InsertOrReplaceResource(resource Resource) {
+	isPending := commands.addPendingResource(resource)
+	commands.Run(func(_ *ecs.World) error {
+		if isPending {
+			commands.resources.removePending(reflect.TypeOf(resource))
+		}
+
+		if err := commands.resources.insertOrReplace(resource); err != nil {
+			return fmt.Errorf("failed to insert or replace resource %s: %w", getResourceDebugType(resource), err)
+		}
+
+		return nil
+	})
+}
+
+// CommandsRemoveResource records removing the resource of type T. Systems that use the resource, other than with
+// [Option] or [OptionMut], do not run until it is inserted again. A warning is logged when they are skipped.
+func CommandsRemoveResource[T Resource](commands *Commands) {
+	commands.Run(func(_ *ecs.World) error {
+		if err := commands.resources.remove(reflect.TypeFor[T]()); err != nil {
+			return fmt.Errorf("failed to remove resource %s: %w", reflect.TypeFor[T]().String(), err)
+		}
+
+		return nil
+	})
+}
+
+// addPendingResource records that resource is going to be inserted, so that systems that use it can be added before
+// the command is applied. Returns false if resource is not valid, in which case applying the command fails.
+func (commands *Commands) addPendingResource(resource Resource) bool {
+	resourceType := reflect.TypeOf(resource)
+	if resourceType == nil || resourceType.Kind() != reflect.Pointer {
+		return false
+	}
+
+	commands.resources.addPending(resourceType)
+	return true
+}
+
+// applyCommands applies the commands of all systems in the SystemSet, in the order that the systems were added.
+func (s *SystemSet) applyCommands(world *ecs.World) []error {
+	errors := []error{}
+
+	for _, commands := range s.commands {
+		if err := commands.Apply(world); err != nil {
+			errors = append(errors, fmt.Errorf("failed to apply commands: %w", err))
+		}
+	}
+
+	return errors
+}
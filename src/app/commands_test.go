@@ -0,0 +1,290 @@
+package app
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/lucdrenth/murphecs/src/ecs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandsSystemParam(t *testing.T) {
+	type componentA struct{ ecs.Component }
+
+	t.Run("returns an error when using a non-pointer commands param", func(t *testing.T) {
+		assert := assert.New(t)
+
+		systemSet := SystemSet{}
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+
+		err := systemSet.add(func(_ Commands) {}, &world, nil, &logger, &resourceStorage)
+		assert.ErrorIs(err, ErrSystemParamNotAPointer)
+	})
+
+	t.Run("commands are applied after all systems of the system set have run", func(t *testing.T) {
+		assert := assert.New(t)
+
+		systemSet := SystemSet{}
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+
+		entitiesSeenBySecondSystem := -1
+		err := systemSet.add(func(commands *Commands) {
+			commands.Spawn(&componentA{})
+		}, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+		err = systemSet.add(func(world *ecs.World) {
+			entitiesSeenBySecondSystem = world.CountEntities()
+		}, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Equal(0, entitiesSeenBySecondSystem)
+		assert.Equal(1, world.CountEntities())
+
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Equal(1, entitiesSeenBySecondSystem)
+		assert.Equal(2, world.CountEntities())
+	})
+
+	t.Run("returns errors of commands that failed", func(t *testing.T) {
+		assert := assert.New(t)
+
+		systemSet := SystemSet{}
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+
+		entity, err := ecs.Spawn(&world)
+		assert.NoError(err)
+		err = ecs.Delete(&world, entity)
+		assert.NoError(err)
+
+		err = systemSet.add(func(commands *Commands) {
+			commands.Delete(entity)
+		}, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+
+		errs := systemSet.Exec(&world, nil)
+		assert.Len(errs, 1)
+		assert.ErrorIs(errs[0], ecs.ErrEntityNotFound)
+	})
+}
+
+func TestResourceCommands(t *testing.T) {
+	type level struct{ number int }
+
+	t.Run("inserted resource is available to systems from the next run", func(t *testing.T) {
+		assert := assert.New(t)
+
+		systemSet := SystemSet{}
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+
+		isPresent := false
+		err := systemSet.add(func(commands *Commands, current *Option[level]) {
+			_, isPresent = current.Get()
+			if !isPresent {
+				commands.InsertResource(&level{number: 1})
+			}
+		}, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.False(isPresent)
+
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.True(isPresent)
+	})
+
+	t.Run("systems can be added while a command that inserts their resource is pending", func(t *testing.T) {
+		assert := assert.New(t)
+
+		systemSet := SystemSet{}
+		world := ecs.NewDefaultWorld()
+		logger := testLogger{}
+		resourceStorage := newResourceStorage()
+
+		commands := Commands{}
+		assert.NoError(commands.init(&resourceStorage))
+		commands.InsertResource(&level{number: 1})
+
+		numberByValue, numberByReference, numberByRes, numberByResMut := 0, 0, 0, 0
+		err := systemSet.add(func(current level) {
+			numberByValue = current.number
+		}, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+		err = systemSet.add(func(current *level) {
+			numberByReference = current.number
+		}, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+		err = systemSet.add(func(current *Res[level]) {
+			numberByRes = current.Get().number
+		}, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+		err = systemSet.add(func(current *ResMut[level]) {
+			numberByResMut = current.Get().number
+		}, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+
+		// skipped systems are logged once
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Equal([]int{0, 0, 0, 0}, []int{numberByValue, numberByReference, numberByRes, numberByResMut})
+		assert.Equal(uint(4), logger.warn)
+
+		assert.NoError(commands.Apply(&world))
+
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Equal([]int{1, 1, 1, 1}, []int{numberByValue, numberByReference, numberByRes, numberByResMut})
+		assert.Equal(uint(4), logger.warn)
+	})
+
+	t.Run("systems can not be added once the command that inserts their resource failed", func(t *testing.T) {
+		assert := assert.New(t)
+
+		systemSet := SystemSet{}
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+		assert.NoError(registerBlacklistedResource[*level](&resourceStorage))
+
+		commands := Commands{}
+		assert.NoError(commands.init(&resourceStorage))
+		commands.InsertResource(&level{number: 1})
+		assert.ErrorIs(commands.Apply(&world), ErrResourceTypeNotAllowed)
+
+		err := systemSet.add(func(_ *level) {}, &world, nil, &logger, &resourceStorage)
+		assert.ErrorIs(err, ErrSystemParamNotValid)
+	})
+
+	t.Run("systems do not run while their resource is removed and pick it up when it is inserted again", func(t *testing.T) {
+		assert := assert.New(t)
+
+		systemSet := SystemSet{}
+		world := ecs.NewDefaultWorld()
+		logger := testLogger{}
+		resourceStorage := newResourceStorage()
+		assert.NoError(resourceStorage.add(&level{number: 1}))
+
+		numberByValue, numberByReference, numberByRes, numberByResMut := 0, 0, 0, 0
+		err := systemSet.add(func(current level) {
+			numberByValue = current.number
+		}, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+		err = systemSet.add(func(current *level) {
+			numberByReference = current.number
+		}, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+		err = systemSet.add(func(current *Res[level]) {
+			numberByRes = current.Get().number
+		}, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+		err = systemSet.add(func(current *ResMut[level]) {
+			numberByResMut = current.Get().number
+		}, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+
+		commandsSet := SystemSet{}
+		nextLevel := 0
+		err = commandsSet.add(func(commands *Commands) {
+			if nextLevel == 0 {
+				CommandsRemoveResource[level](commands)
+			} else {
+				commands.InsertResource(&level{number: nextLevel})
+			}
+		}, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+
+		assert.Empty(commandsSet.Exec(&world, nil))
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Equal(0, numberByValue)
+		assert.Equal(0, numberByReference)
+		assert.Equal(0, numberByRes)
+		assert.Equal(0, numberByResMut)
+		assert.Equal(uint(4), logger.warn)
+
+		nextLevel = 2
+		assert.Empty(commandsSet.Exec(&world, nil))
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Equal(2, numberByValue)
+		assert.Equal(2, numberByReference)
+		assert.Equal(2, numberByRes)
+		assert.Equal(2, numberByResMut)
+		assert.Equal(uint(4), logger.warn)
+	})
+
+	t.Run("systems use the replaced resource from the next run", func(t *testing.T) {
+		assert := assert.New(t)
+
+		systemSet := SystemSet{}
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+		assert.NoError(resourceStorage.add(&level{number: 1}))
+
+		seen := []int{}
+		err := systemSet.add(func(commands *Commands, current level) {
+			seen = append(seen, current.number)
+			commands.InsertOrReplaceResource(&level{number: current.number + 1})
+		}, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Equal([]int{1, 2, 3}, seen)
+	})
+
+	t.Run("run conditions are false while their resource is removed", func(t *testing.T) {
+		assert := assert.New(t)
+
+		systemSet := SystemSet{}
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+		assert.NoError(resourceStorage.add(&level{number: 1}))
+
+		runs := 0
+		err := systemSet.add(Configure(func() { runs++ }).RunIf(func(_ *Res[level]) bool { return true }), &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Equal(1, runs)
+
+		assert.NoError(resourceStorage.remove(reflect.TypeFor[level]()))
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Equal(1, runs)
+	})
+
+	t.Run("returns errors of resource commands that failed", func(t *testing.T) {
+		assert := assert.New(t)
+
+		systemSet := SystemSet{}
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+		assert.NoError(resourceStorage.add(&level{}))
+		assert.NoError(resourceStorage.add(&Time{}))
+		assert.NoError(registerBlacklistedResource[*Time](&resourceStorage))
+
+		err := systemSet.add(func(commands *Commands) {
+			commands.InsertResource(&level{})
+			commands.InsertResource(level{})
+			CommandsRemoveResource[*FixedTimestep](commands)
+			CommandsRemoveResource[Time](commands)
+		}, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+
+		errs := systemSet.Exec(&world, nil)
+		assert.Len(errs, 1)
+		assert.ErrorIs(errs[0], ErrResourceAlreadyPresent)
+		assert.ErrorIs(errs[0], ErrResourceNotAPointer)
+		assert.ErrorIs(errs[0], ErrResourceNotFound)
+		assert.ErrorIs(errs[0], ErrResourceTypeNotAllowed)
+	})
+}
@@ -0,0 +1,48 @@
+package app
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+var _ context.Context = (*runContext)(nil)
+
+// runContext is the context.Context that is passed to systems. It forwards all calls to the context that the app
+// currently runs with, so that systems that were added before the app runs see the context of the run.
+type runContext struct {
+	mutex sync.RWMutex
+	ctx   context.Context
+}
+
+func newRunContext() *runContext {
+	return &runContext{ctx: context.Background()}
+}
+
+func (c *runContext) set(ctx context.Context) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.ctx = ctx
+}
+
+func (c *runContext) current() context.Context {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.ctx
+}
+
+func (c *runContext) Deadline() (deadline time.Time, ok bool) {
+	return c.current().Deadline()
+}
+
+func (c *runContext) Done() <-chan struct{} {
+	return c.current().Done()
+}
+
+func (c *runContext) Err() error {
+	return c.current().Err()
+}
+
+func (c *runContext) Value(key any) any {
+	return c.current().Value(key)
+}
@@ -0,0 +1,168 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lucdrenth/murphecs/src/ecs"
+	"github.com/stretchr/testify/assert"
+)
+
+type contextKey struct{}
+
+// contextRunner runs the systems once and returns the value of contextKey that it got.
+type contextRunner struct {
+	value any
+}
+
+func (runner *contextRunner) Run(exitChannel <-chan struct{}, systems []*SystemSet) {}
+
+func (runner *contextRunner) RunContext(ctx context.Context, systems []*SystemSet) error {
+	runner.value = ctx.Value(contextKey{})
+	return errRunnerFailed
+}
+
+var errRunnerFailed = errors.New("runner failed")
+
+func TestRunContext(t *testing.T) {
+	t.Run("stops when the context is canceled", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+		app.SetTickRate(time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		assert.NoError(app.RunContext(ctx))
+		assert.Equal(uint(0), logger.err)
+	})
+
+	t.Run("passes the context to systems", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+		app.SetTickRate(time.Millisecond)
+
+		const cleanupSchedule Schedule = "cleanup"
+		app.AddSchedule(testSchedule, ScheduleTypeRepeating)
+		app.AddSchedule(cleanupSchedule, ScheduleTypeCleanup)
+
+		var value any
+		var cleanupErr error
+		app.AddSystem(testSchedule, func(ctx context.Context, exit *Exit) {
+			value = ctx.Value(contextKey{})
+			exit.Request()
+		})
+		app.AddSystem(cleanupSchedule, func(ctx context.Context) {
+			cleanupErr = ctx.Err()
+		})
+
+		ctx := context.WithValue(context.Background(), contextKey{}, "value")
+		assert.NoError(app.RunContext(ctx))
+		assert.Equal("value", value)
+		assert.NoError(cleanupErr)
+		assert.Equal(uint(0), logger.err)
+	})
+
+	t.Run("uses RunContext of a ContextRunner", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+
+		runner := contextRunner{}
+		app.SetRunner(&runner)
+
+		ctx := context.WithValue(context.Background(), contextKey{}, "value")
+		assert.ErrorIs(app.RunContext(ctx), errRunnerFailed)
+		assert.Equal("value", runner.value)
+	})
+
+	t.Run("returns the error of the runner after running the cleanup schedules", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+
+		const cleanupSchedule Schedule = "cleanup"
+		app.AddSchedule(cleanupSchedule, ScheduleTypeCleanup)
+		isCleanedUp := false
+		app.AddSystem(cleanupSchedule, func() { isCleanedUp = true })
+		app.SetRunner(&contextRunner{})
+
+		err = app.RunContext(context.Background())
+		assert.ErrorIs(err, errRunnerFailed)
+		assert.ErrorContains(err, "runner returned error")
+		assert.True(isCleanedUp)
+		assert.Equal(uint(0), logger.err)
+	})
+
+	t.Run("App returns the error of the runner of a sub app", func(t *testing.T) {
+		assert := assert.New(t)
+
+		subApp, err := New(nil, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+		subApp.SetRunner(&contextRunner{})
+
+		app := NewApp(nil)
+		app.AddSubApp("Failing", &subApp)
+
+		err = app.RunContext(context.Background())
+		assert.ErrorIs(err, errRunnerFailed)
+		assert.ErrorContains(err, "Failing: runner returned error")
+	})
+
+	t.Run("returns an error if the app can not be run", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+
+		app.AddSchedule(testSchedule, ScheduleTypeRepeating)
+		app.AddSystem(testSchedule, Configure(func() {}).After("unknown"))
+
+		assert.ErrorIs(app.RunContext(context.Background()), ErrSystemLabelNotFound)
+	})
+
+	t.Run("system sets that are not part of an app get a background context", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+
+		var ctx context.Context
+		systemSet := SystemSet{}
+		err := systemSet.add(func(c context.Context) { ctx = c }, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Equal(context.Background(), ctx)
+	})
+
+	t.Run("App stops when the context is canceled", func(t *testing.T) {
+		assert := assert.New(t)
+
+		subApp, err := New(nil, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+		subApp.SetTickRate(time.Millisecond)
+
+		app := NewApp(nil)
+		app.AddSubApp("A", &subApp)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		assert.NoError(app.RunContext(ctx))
+	})
+}
@@ -14,7 +14,18 @@ var (
 	ErrSystemParamQueryNotAPointer error = errors.New("query must be a pointer")
 	ErrSystemParamQueryNotValid    error = errors.New("query param not valid")
 	ErrSystemParamWorldNotAPointer error = errors.New("world must be a pointer")
+	ErrSystemParamNotAPointer      error = errors.New("system param must be a pointer")
 	ErrSystemParamNotValid         error = errors.New("not valid")
+	ErrSystemLabelNotFound         error = errors.New("system label not found")
+	ErrSystemOrderCycle            error = errors.New("system order has a cycle")
+	ErrRunConditionNotValid        error = errors.New("run condition not valid")
+	ErrStateAlreadyPresent         error = errors.New("state already present")
 
 	ErrTargetWorldNotKnown error = errors.New("target world not known")
+
+	ErrFeatureDependencyNotFound error = errors.New("feature dependency not found")
+	ErrFeatureDependencyCycle    error = errors.New("feature dependencies have a cycle")
+
+	ErrSubAppAlreadyPresent error = errors.New("sub app already present")
+	ErrShutdownTimeout      error = errors.New("did not shut down within timeout")
 )
@@ -0,0 +1,153 @@
+package app
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Events holds the events of type T that are sent by an EventWriter. It is double-buffered: events are kept for
+// the frame in which they were sent and the frame after, so that every EventReader gets to see them exactly once,
+// regardless of wether the reader runs before or after the writer. A frame is a single tick, unless the
+// fixed-timestep runner catches up on multiple ticks in one frame. Frames of the fixed-timestep runner in which no
+// tick ran do not count, so that the repeated schedules do not miss events when frames are more frequent than ticks.
+//
+// Events are added as a resource when a system uses an EventWriter or EventReader for the first time.
+type Events[T any] struct {
+	previous        []T  // events that were sent during the previous frame
+	current         []T  // events that were sent during the current frame
+	previousStartId uint // id of the first event in previous
+	currentStartId  uint // id of the first event in current
+	nextId          uint // id of the next event that will be sent
+}
+
+func (events *Events[T]) send(event T) {
+	events.current = append(events.current, event)
+	events.nextId++
+}
+
+// get returns the event with the given id. The id must be in between previousStartId and nextId.
+func (events *Events[T]) get(id uint) T {
+	if id < events.currentStartId {
+		return events.previous[id-events.previousStartId]
+	}
+
+	return events.current[id-events.currentStartId]
+}
+
+// update drops the events of the previous frame and moves the events of the current frame to the previous frame.
+func (events *Events[T]) update() {
+	clear(events.previous) // make sure events with pointers can be garbage collected
+	events.previous, events.current = events.current, events.previous[:0]
+	events.previousStartId = events.currentStartId
+	events.currentStartId = events.nextId
+}
+
+// Len returns the number of events that are currently kept.
+func (events *Events[T]) Len() int {
+	return len(events.previous) + len(events.current)
+}
+
+type eventUpdater interface {
+	update()
+}
+
+// updateEvents updates all Events resources. This should be done once at the end of every frame.
+func updateEvents(resources *resourceStorage) {
+	for _, resource := range resources.resources {
+		if events, ok := resource.(eventUpdater); ok {
+			events.update()
+		}
+	}
+}
+
+// newUpdateEventsSystemSet returns a SystemSet that updates all Events resources. It is run at the end of every
+// frame in which a tick ran, after the frame systems, so that custom runners update events without having to be
+// aware of them.
+func newUpdateEventsSystemSet(resources *resourceStorage) *SystemSet {
+	systemSet := &SystemSet{}
+	systemSet.addEntry(systemEntry{system: reflect.ValueOf(func() { updateEvents(resources) })}, 0)
+	return systemSet
+}
+
+// getOrAddEvents returns the Events resource for event type T, and adds it if it does not exist yet.
+func getOrAddEvents[T any](resources *resourceStorage) (*Events[T], error) {
+	events, err := getResourceFromStorage[*Events[T]](resources)
+	if err == nil {
+		return events, nil
+	}
+
+	events = &Events[T]{}
+	if err := resources.add(events); err != nil {
+		return nil, fmt.Errorf("failed to add events resource: %w", err)
+	}
+
+	return events, nil
+}
+
+// EventWriter is a system param that can send events of type T. Send events to the EventReaders of type T.
+//
+// Must be used as a pointer: *EventWriter[T].
+type EventWriter[T any] struct {
+	events *Events[T]
+}
+
+func (writer *EventWriter[T]) init(resources *resourceStorage) (err error) {
+	writer.events, err = getOrAddEvents[T](resources)
+	return err
+}
+
+func (writer *EventWriter[T]) access() []resourceAccess {
+	return []resourceAccess{{resourceId: reflectTypeToComponentId(reflect.TypeFor[*Events[T]]()), mutable: true}}
+}
+
+// Send sends an event that can be read by EventReaders of the same type in this frame and the next frame.
+func (writer *EventWriter[T]) Send(event T) {
+	writer.events.send(event)
+}
+
+// EventReader is a system param that reads events of type T that were sent by an EventWriter. Each EventReader
+// keeps track of which events it has read, so that it sees every event exactly once.
+//
+// Must be used as a pointer: *EventReader[T].
+type EventReader[T any] struct {
+	events *Events[T]
+	nextId uint // id of the next event to read
+}
+
+func (reader *EventReader[T]) init(resources *resourceStorage) (err error) {
+	reader.events, err = getOrAddEvents[T](resources)
+	return err
+}
+
+func (reader *EventReader[T]) access() []resourceAccess {
+	return []resourceAccess{{resourceId: reflectTypeToComponentId(reflect.TypeFor[*Events[T]]())}}
+}
+
+// Read lets you range over the events that this reader has not read yet. Events that are ranged over are
+// marked as read.
+//
+// for event := range reader.Read() { ... }
+func (reader *EventReader[T]) Read() func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		reader.nextId = max(reader.nextId, reader.events.previousStartId)
+
+		for reader.nextId < reader.events.nextId {
+			event := reader.events.get(reader.nextId)
+			reader.nextId++
+
+			if !yield(event) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns the number of events that this reader has not read yet.
+func (reader *EventReader[T]) Len() int {
+	return int(reader.events.nextId - max(reader.nextId, reader.events.previousStartId))
+}
+
+// Clear marks all events as read without reading them.
+func (reader *EventReader[T]) Clear() {
+	reader.nextId = reader.events.nextId
+}
@@ -0,0 +1,153 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/lucdrenth/murphecs/src/ecs"
+	"github.com/stretchr/testify/assert"
+)
+
+type testEvent struct {
+	value int
+}
+
+func TestEvents(t *testing.T) {
+	readAll := func(reader *EventReader[testEvent]) []int {
+		result := []int{}
+		for event := range reader.Read() {
+			result = append(result, event.value)
+		}
+		return result
+	}
+
+	t.Run("reader reads events of the current and the previous tick exactly once", func(t *testing.T) {
+		assert := assert.New(t)
+
+		resources := newResourceStorage()
+		writer := EventWriter[testEvent]{}
+		assert.NoError(writer.init(&resources))
+		reader := EventReader[testEvent]{}
+		assert.NoError(reader.init(&resources))
+
+		writer.Send(testEvent{value: 1})
+		writer.Send(testEvent{value: 2})
+		assert.Equal(2, reader.Len())
+		assert.Equal([]int{1, 2}, readAll(&reader))
+		assert.Equal([]int{}, readAll(&reader))
+
+		updateEvents(&resources)
+		writer.Send(testEvent{value: 3})
+		assert.Equal([]int{3}, readAll(&reader))
+
+		updateEvents(&resources)
+		updateEvents(&resources)
+		assert.Equal([]int{}, readAll(&reader))
+	})
+
+	t.Run("events are dropped after the tick after they were sent", func(t *testing.T) {
+		assert := assert.New(t)
+
+		resources := newResourceStorage()
+		writer := EventWriter[testEvent]{}
+		assert.NoError(writer.init(&resources))
+		reader := EventReader[testEvent]{}
+		assert.NoError(reader.init(&resources))
+
+		writer.Send(testEvent{value: 1})
+		updateEvents(&resources)
+		writer.Send(testEvent{value: 2})
+		assert.Equal(2, writer.events.Len())
+
+		updateEvents(&resources)
+		assert.Equal(1, writer.events.Len())
+		assert.Equal([]int{2}, readAll(&reader))
+
+		updateEvents(&resources)
+		assert.Equal(0, writer.events.Len())
+	})
+
+	t.Run("multiple readers read events independently", func(t *testing.T) {
+		assert := assert.New(t)
+
+		resources := newResourceStorage()
+		writer := EventWriter[testEvent]{}
+		assert.NoError(writer.init(&resources))
+		readerA := EventReader[testEvent]{}
+		assert.NoError(readerA.init(&resources))
+		readerB := EventReader[testEvent]{}
+		assert.NoError(readerB.init(&resources))
+
+		writer.Send(testEvent{value: 1})
+		assert.Equal([]int{1}, readAll(&readerA))
+		writer.Send(testEvent{value: 2})
+		updateEvents(&resources)
+
+		assert.Equal([]int{2}, readAll(&readerA))
+		assert.Equal([]int{1, 2}, readAll(&readerB))
+	})
+
+	t.Run("Clear marks all events as read", func(t *testing.T) {
+		assert := assert.New(t)
+
+		resources := newResourceStorage()
+		writer := EventWriter[testEvent]{}
+		assert.NoError(writer.init(&resources))
+		reader := EventReader[testEvent]{}
+		assert.NoError(reader.init(&resources))
+
+		writer.Send(testEvent{value: 1})
+		reader.Clear()
+		assert.Equal(0, reader.Len())
+		assert.Equal([]int{}, readAll(&reader))
+	})
+}
+
+func TestEventSystemParams(t *testing.T) {
+	t.Run("returns an error when using a non-pointer event param", func(t *testing.T) {
+		assert := assert.New(t)
+
+		systemSet := SystemSet{}
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+
+		err := systemSet.add(func(_ EventWriter[testEvent]) {}, &world, nil, &logger, &resourceStorage)
+		assert.ErrorIs(err, ErrSystemParamNotAPointer)
+		err = systemSet.add(func(_ EventReader[testEvent]) {}, &world, nil, &logger, &resourceStorage)
+		assert.ErrorIs(err, ErrSystemParamNotAPointer)
+	})
+
+	t.Run("reader system receives events that are sent by writer system", func(t *testing.T) {
+		assert := assert.New(t)
+
+		systemSet := SystemSet{}
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+
+		received := []int{}
+		tick := 0
+
+		// reader is added before the writer, so it reads the events in the tick after they were sent
+		err := systemSet.add(func(reader *EventReader[testEvent]) {
+			for event := range reader.Read() {
+				received = append(received, event.value)
+			}
+		}, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+		err = systemSet.add(func(writer *EventWriter[testEvent]) {
+			tick++
+			writer.Send(testEvent{value: tick})
+		}, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+
+		updateEventsSystemSet := newUpdateEventsSystemSet(&resourceStorage)
+		for range 3 {
+			assert.Empty(systemSet.Exec(&world, nil))
+			assert.Empty(updateEventsSystemSet.Exec(&world, nil))
+		}
+
+		assert.Equal([]int{1, 2}, received)
+		assert.Len(resourceStorage.resources, 1)
+	})
+}
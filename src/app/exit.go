@@ -0,0 +1,38 @@
+package app
+
+import "sync"
+
+// Exit is a reserved resource that systems can use to request their app to stop running. When the app is run by an
+// [App], all of its other SubApps are stopped as well.
+//
+// Use it as a system param by reference:
+//
+//	func quit(exit *app.Exit) { exit.Request() }
+type Exit struct {
+	channel chan struct{}
+	once    *sync.Once
+}
+
+func newExit() *Exit {
+	return &Exit{
+		channel: make(chan struct{}),
+		once:    &sync.Once{},
+	}
+}
+
+// Request requests the app to stop running. It can safely be called multiple times.
+func (exit *Exit) Request() {
+	exit.once.Do(func() {
+		close(exit.channel)
+	})
+}
+
+// IsRequested returns wether an exit has been requested.
+func (exit *Exit) IsRequested() bool {
+	select {
+	case <-exit.channel:
+		return true
+	default:
+		return false
+	}
+}
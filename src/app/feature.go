@@ -1,8 +1,11 @@
 package app
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
+	"slices"
+	"strings"
 
 	"github.com/lucdrenth/murphecs/src/utils"
 )
@@ -12,9 +15,26 @@ type IFeature interface {
 	GetResources() []Resource
 	GetSystems() []FeatureSystem
 
-	// GetAndInitNestedFeatures recursively gets and Inits all features. It needs to init them so
-	// that we can get its nested features.
-	GetAndInitNestedFeatures() []IFeature
+	// GetNestedFeatures returns the features that were added to this feature, without initializing them.
+	GetNestedFeatures() []IFeature
+
+	// GetDependencies returns the types of the features that must be added before this feature.
+	GetDependencies() []reflect.Type
+}
+
+// FeatureBuilder can be implemented by a feature that needs access to the SubApp when it is added, for example to
+// add schedules or states. Build is called after the resources of the feature are added and before its systems are
+// added. If Build returns an error, the systems of the feature are not added and its resources are removed again, so
+// that the feature can be added again later.
+type FeatureBuilder interface {
+	Build(app *SubApp) error
+}
+
+// FeatureFinisher can be implemented by a feature that needs to do something once all features are added, such as
+// checking if an optional feature is present. Finish is called right before the app runs or is started up, in the
+// order that the features were added. If Finish returns an error, the app does not run.
+type FeatureFinisher interface {
+	Finish(app *SubApp) error
 }
 
 // Feature is a set of resources and systems that will be initialized and added to an app before the
@@ -23,10 +43,15 @@ type IFeature interface {
 //
 // Added systems and resources will not be directly verified. It will be done once the app processes
 // the features, which is done before running the app.
+//
+// Features are identified by their type. A feature of which the type was already added to the app, either directly
+// or as a nested feature, is ignored. Features are added after the features they depend on, which are their nested
+// features and the features that are declared with [FeatureDependsOn].
 type Feature struct {
-	resources []Resource
-	systems   []FeatureSystem
-	features  []IFeature
+	resources    []Resource
+	systems      []FeatureSystem
+	features     []IFeature
+	dependencies []reflect.Type
 }
 
 type FeatureSystem struct {
@@ -49,6 +74,18 @@ func (feature *Feature) AddFeature(f IFeature) *Feature {
 	return feature
 }
 
+// FeatureDependsOn declares that feature depends on the feature of type T, which must be a pointer type. Unlike
+// nested features, T is not added by feature. Adding feature fails if T is not added to the app before or
+// together with it.
+//
+//	func (f *debugOverlayFeature) Init() {
+//		app.FeatureDependsOn[*physicsFeature](&f.Feature)
+//	}
+func FeatureDependsOn[T IFeature](feature *Feature) *Feature {
+	feature.dependencies = append(feature.dependencies, reflect.TypeFor[T]())
+	return feature
+}
+
 func (feature *Feature) GetResources() []Resource {
 	return feature.resources
 }
@@ -57,16 +94,12 @@ func (feature *Feature) GetSystems() []FeatureSystem {
 	return feature.systems
 }
 
-func (feature *Feature) GetAndInitNestedFeatures() []IFeature {
-	result := []IFeature{}
-
-	for _, nestedFeature := range feature.features {
-		nestedFeature.Init()
-		result = append(result, nestedFeature)
-		result = append(result, nestedFeature.GetAndInitNestedFeatures()...)
-	}
+func (feature *Feature) GetNestedFeatures() []IFeature {
+	return feature.features
+}
 
-	return result
+func (feature *Feature) GetDependencies() []reflect.Type {
+	return feature.dependencies
 }
 
 func validateFeature(feature IFeature) error {
@@ -80,3 +113,129 @@ func validateFeature(feature IFeature) error {
 
 	return nil
 }
+
+// resolveFeatures initializes feature and its nested features, and returns the ones of which the type is not added
+// to app yet. They are deduplicated by type and sorted so that every feature comes after the features it depends
+// on. Features that are not valid are logged and left out.
+//
+// Can return the following errors:
+//   - ErrFeatureDependencyNotFound if a dependency is neither installed nor part of the returned features.
+//   - ErrFeatureDependencyCycle if features depend on each other.
+func (app *SubApp) resolveFeatures(feature IFeature) ([]IFeature, error) {
+	installed := app.featureTypes
+	features := []IFeature{}
+	isCollected := map[reflect.Type]bool{}
+
+	var collect func(feature IFeature)
+	collect = func(feature IFeature) {
+		featureType := reflect.TypeOf(feature)
+		if installed[featureType] || isCollected[featureType] {
+			return
+		}
+		isCollected[featureType] = true
+
+		if err := validateFeature(feature); err != nil {
+			app.logger.Error(fmt.Sprintf("%s - %v", app.name, err))
+			return
+		}
+
+		feature.Init()
+		features = append(features, feature)
+		for _, nested := range feature.GetNestedFeatures() {
+			collect(nested)
+		}
+	}
+	collect(feature)
+
+	indices := map[reflect.Type]int{}
+	for i, feature := range features {
+		indices[reflect.TypeOf(feature)] = i
+	}
+
+	// predecessors[i] are the indices of the features that must be added before feature i
+	predecessors := make([][]int, len(features))
+	for i, feature := range features {
+		for _, nested := range feature.GetNestedFeatures() {
+			// nested features that are installed already or that are not valid do not have to be ordered
+			if j, ok := indices[reflect.TypeOf(nested)]; ok {
+				predecessors[i] = append(predecessors[i], j)
+			}
+		}
+
+		for _, dependency := range feature.GetDependencies() {
+			if j, ok := indices[dependency]; ok {
+				predecessors[i] = append(predecessors[i], j)
+			} else if !installed[dependency] {
+				return nil, fmt.Errorf("%w: %s depends on %s", ErrFeatureDependencyNotFound, featureDebugType(feature), dependency.String())
+			}
+		}
+	}
+
+	order := make([]IFeature, 0, len(features))
+	isOrdered := make([]bool, len(features))
+	for len(order) < len(features) {
+		next := -1
+		for i := range features {
+			isNotOrdered := func(j int) bool { return !isOrdered[j] }
+			if !isOrdered[i] && !slices.ContainsFunc(predecessors[i], isNotOrdered) {
+				next = i
+				break
+			}
+		}
+
+		if next == -1 {
+			unordered := []string{}
+			for i, feature := range features {
+				if !isOrdered[i] {
+					unordered = append(unordered, featureDebugType(feature))
+				}
+			}
+			return nil, fmt.Errorf("%w: %s", ErrFeatureDependencyCycle, strings.Join(unordered, ", "))
+		}
+
+		isOrdered[next] = true
+		order = append(order, features[next])
+	}
+
+	return order, nil
+}
+
+// findFailedDependency looks up the nested features and dependencies of feature in failedBy, and returns the type
+// of the feature that failed to build for the first one that is found.
+func findFailedDependency(feature IFeature, failedBy map[reflect.Type]reflect.Type) (reflect.Type, bool) {
+	for _, nested := range feature.GetNestedFeatures() {
+		if root, ok := failedBy[reflect.TypeOf(nested)]; ok {
+			return root, true
+		}
+	}
+
+	for _, dependency := range feature.GetDependencies() {
+		if root, ok := failedBy[dependency]; ok {
+			return root, true
+		}
+	}
+
+	return nil, false
+}
+
+// finishFeatures calls Finish on the features that implement FeatureFinisher and that are not finished yet, and
+// returns the errors joined together.
+func (app *SubApp) finishFeatures() error {
+	features := app.unfinishedFeatures
+	app.unfinishedFeatures = nil
+
+	errs := []error{}
+	for _, feature := range features {
+		if finisher, ok := feature.(FeatureFinisher); ok {
+			if err := finisher.Finish(app); err != nil {
+				errs = append(errs, fmt.Errorf("failed to finish feature %s: %w", featureDebugType(feature), err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func featureDebugType(feature IFeature) string {
+	return reflect.TypeOf(feature).String()
+}
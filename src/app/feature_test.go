@@ -1,8 +1,10 @@
 package app
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/lucdrenth/murphecs/src/ecs"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -23,19 +25,277 @@ func (f *testFeatureD) Init() {}
 func TestNestedFeature(t *testing.T) {
 	assert := assert.New(t)
 
-	var feature IFeature = &testFeatureA{}
-	feature.Init()
-	assert.Len(feature.GetAndInitNestedFeatures(), 0)
+	logger := testLogger{}
+	app, err := New(&logger, ecs.DefaultWorldConfigs())
+	assert.NoError(err)
 
-	feature = &testFeatureB{}
-	feature.Init()
-	assert.Len(feature.GetAndInitNestedFeatures(), 3)
+	features, err := app.resolveFeatures(&testFeatureA{})
+	assert.NoError(err)
+	assert.Len(features, 1)
 
-	feature = &testFeatureC{}
-	feature.Init()
-	assert.Len(feature.GetAndInitNestedFeatures(), 2)
+	features, err = app.resolveFeatures(&testFeatureB{})
+	assert.NoError(err)
+	assert.Len(features, 4)
 
-	feature = &testFeatureD{}
-	feature.Init()
-	assert.Len(feature.GetAndInitNestedFeatures(), 0)
+	features, err = app.resolveFeatures(&testFeatureC{})
+	assert.NoError(err)
+	assert.Len(features, 3)
+
+	features, err = app.resolveFeatures(&testFeatureD{})
+	assert.NoError(err)
+	assert.Len(features, 1)
+
+	// features that nest each other do not recurse forever
+	_, err = app.resolveFeatures(&testCycleFeatureA{})
+	assert.ErrorIs(err, ErrFeatureDependencyCycle)
+}
+
+type testResourceForOrderFeature struct{}
+
+type testOrderFeatureA struct {
+	Feature
+	order *[]string
+}
+type testOrderFeatureB struct {
+	Feature
+	order *[]string
+}
+type testOrderFeatureC struct {
+	Feature
+	order *[]string
+}
+
+func (f *testOrderFeatureA) Init() {
+	f.AddResource(&testResourceForOrderFeature{})
+}
+func (f *testOrderFeatureB) Init() {
+	f.AddFeature(&testOrderFeatureA{order: f.order})
+}
+func (f *testOrderFeatureC) Init() {
+	f.AddFeature(&testOrderFeatureB{order: f.order}).AddFeature(&testOrderFeatureA{order: f.order})
+}
+func (f *testOrderFeatureA) Build(_ *SubApp) error {
+	*f.order = append(*f.order, "A")
+	return nil
+}
+func (f *testOrderFeatureB) Build(_ *SubApp) error {
+	*f.order = append(*f.order, "B")
+	return nil
+}
+func (f *testOrderFeatureC) Build(_ *SubApp) error {
+	*f.order = append(*f.order, "C")
+	return nil
+}
+
+type testDependentFeature struct {
+	Feature
+	order *[]string
+}
+
+func (f *testDependentFeature) Init() {
+	FeatureDependsOn[*testOrderFeatureA](&f.Feature)
+	f.AddSystem(testSchedule, func() {})
+}
+func (f *testDependentFeature) Build(_ *SubApp) error {
+	*f.order = append(*f.order, "dependent")
+	return nil
+}
+
+type testCycleFeatureA struct{ Feature }
+type testCycleFeatureB struct{ Feature }
+
+func (f *testCycleFeatureA) Init() {
+	f.AddFeature(&testCycleFeatureB{})
+}
+func (f *testCycleFeatureB) Init() {
+	f.AddFeature(&testCycleFeatureA{})
+}
+
+type testLifecycleFeature struct {
+	Feature
+	buildErr  error
+	finishErr error
+	finished  int
+}
+
+type testLifecycleResource struct{}
+
+func (f *testLifecycleFeature) Init() {
+	f.AddResource(&testLifecycleResource{})
+	f.AddSystem(testSchedule, func() {})
+}
+func (f *testLifecycleFeature) Build(_ *SubApp) error {
+	return f.buildErr
+}
+func (f *testLifecycleFeature) Finish(_ *SubApp) error {
+	f.finished++
+	return f.finishErr
+}
+
+// testLifecycleParentFeature nests a testLifecycleFeature that fails to build with buildErr and a feature that
+// depends on it.
+type testLifecycleParentFeature struct {
+	Feature
+	buildErr error
+}
+type testLifecycleDependentFeature struct{ Feature }
+
+func (f *testLifecycleParentFeature) Init() {
+	f.AddFeature(&testLifecycleFeature{buildErr: f.buildErr})
+	f.AddFeature(&testLifecycleDependentFeature{})
+	f.AddSystem(testSchedule, func() {})
+}
+func (f *testLifecycleDependentFeature) Init() {
+	FeatureDependsOn[*testLifecycleFeature](&f.Feature)
+	f.AddSystem(testSchedule, func() {})
+}
+
+func TestFeatureDependencies(t *testing.T) {
+	t.Run("features are deduplicated by type and added after their nested features", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+
+		order := []string{}
+		app.AddFeature(&testOrderFeatureC{order: &order})
+		app.AddFeature(&testOrderFeatureB{order: &order})
+		assert.Equal(uint(0), logger.err)
+		assert.Equal([]string{"A", "B", "C"}, order)
+		assert.Equal(uint(1), app.NumberOfResources())
+		assert.True(HasFeature[*testOrderFeatureA](&app))
+		assert.False(HasFeature[*testDependentFeature](&app))
+	})
+
+	t.Run("logs an error and adds nothing if a dependency is not added", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+		app.AddSchedule(testSchedule, ScheduleTypeRepeating)
+
+		order := []string{}
+		app.AddFeature(&testDependentFeature{order: &order})
+		assert.Equal(uint(1), logger.err)
+		assert.ErrorContains(app.Errors()[0], ErrFeatureDependencyNotFound.Error())
+		assert.Empty(order)
+		assert.Equal(uint(0), app.NumberOfSystems())
+		assert.False(HasFeature[*testDependentFeature](&app))
+
+		app.AddFeature(&testOrderFeatureA{order: &order})
+		app.AddFeature(&testDependentFeature{order: &order})
+		assert.Equal(uint(1), logger.err)
+		assert.Equal([]string{"A", "dependent"}, order)
+		assert.Equal(uint(1), app.NumberOfSystems())
+	})
+
+	t.Run("logs an error and adds nothing if features depend on each other", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+
+		app.AddFeature(&testCycleFeatureA{})
+		assert.Equal(uint(1), logger.err)
+		assert.ErrorContains(app.Errors()[0], ErrFeatureDependencyCycle.Error())
+		assert.False(HasFeature[*testCycleFeatureA](&app))
+		assert.False(HasFeature[*testCycleFeatureB](&app))
+	})
+}
+
+func TestFeatureLifecycle(t *testing.T) {
+	t.Run("does not add the systems of a feature if Build returns an error", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+		app.AddSchedule(testSchedule, ScheduleTypeRepeating)
+
+		app.AddFeature(&testLifecycleFeature{buildErr: errors.New("failed")})
+		assert.Equal(uint(1), logger.err)
+		assert.Equal(uint(0), app.NumberOfSystems())
+	})
+
+	t.Run("can add a feature again after its Build returned an error", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+		app.AddSchedule(testSchedule, ScheduleTypeRepeating)
+
+		app.AddFeature(&testLifecycleFeature{buildErr: errors.New("failed")})
+		assert.Equal(uint(1), logger.err)
+		assert.False(HasFeature[*testLifecycleFeature](&app))
+		assert.Equal(uint(0), app.NumberOfResources())
+
+		app.AddFeature(&testLifecycleFeature{})
+		assert.Equal(uint(1), logger.err)
+		assert.True(HasFeature[*testLifecycleFeature](&app))
+		assert.Equal(uint(1), app.NumberOfResources())
+		assert.Equal(uint(1), app.NumberOfSystems())
+	})
+
+	t.Run("skips the features that depend on a feature of which Build returns an error", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+		app.AddSchedule(testSchedule, ScheduleTypeRepeating)
+
+		app.AddFeature(&testLifecycleParentFeature{buildErr: errors.New("failed")})
+		assert.Equal(uint(1), logger.err)
+		assert.ErrorContains(app.Errors()[0], "*app.testLifecycleDependentFeature")
+		assert.ErrorContains(app.Errors()[0], "*app.testLifecycleParentFeature")
+		assert.Equal(uint(0), app.NumberOfSystems())
+		assert.Equal(uint(0), app.NumberOfResources())
+		assert.False(HasFeature[*testLifecycleFeature](&app))
+		assert.False(HasFeature[*testLifecycleDependentFeature](&app))
+		assert.False(HasFeature[*testLifecycleParentFeature](&app))
+
+		app.AddFeature(&testLifecycleParentFeature{})
+		assert.Equal(uint(1), logger.err)
+		assert.Equal(uint(3), app.NumberOfSystems())
+		assert.True(HasFeature[*testLifecycleParentFeature](&app))
+	})
+
+	t.Run("calls Finish once before the app starts up", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+		app.AddSchedule(testSchedule, ScheduleTypeRepeating)
+
+		feature := &testLifecycleFeature{}
+		app.AddFeature(feature)
+		assert.Equal(0, feature.finished)
+
+		runApp(&app, 2)
+		assert.Equal(uint(0), logger.err)
+		assert.Equal(1, feature.finished)
+	})
+
+	t.Run("does not start up if Finish returns an error", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+		app.AddSchedule(testSchedule, ScheduleTypeRepeating)
+
+		runs := 0
+		app.AddSystem(testSchedule, func() { runs++ })
+		app.AddFeature(&testLifecycleFeature{finishErr: errors.New("failed")})
+
+		app.Startup()
+		app.Step(1)
+		assert.Equal(0, runs)
+		assert.ErrorContains(app.Errors()[0], "failed to finish feature")
+	})
 }
@@ -0,0 +1,28 @@
+package app
+
+// Local is a system param that holds a value of type T that is private to the system. Each time that a system is
+// added, it gets its own zero-initialized value that is kept between runs. This makes it useful for state that only
+// a single system needs, such as a counter or a timer, without having to add a resource that any system can use.
+//
+// Must be used as a pointer: *Local[T].
+//
+//	func countRuns(counter *app.Local[int]) {
+//		*counter.Get()++
+//	}
+type Local[T any] struct {
+	value T
+}
+
+func (local *Local[T]) init(resources *resourceStorage) error {
+	return nil
+}
+
+// Get returns a pointer to the value, which can be used to change it.
+func (local *Local[T]) Get() *T {
+	return &local.value
+}
+
+// Set replaces the value.
+func (local *Local[T]) Set(value T) {
+	local.value = value
+}
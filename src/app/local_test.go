@@ -0,0 +1,100 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/lucdrenth/murphecs/src/ecs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocal(t *testing.T) {
+	t.Run("is zero-initialized and kept between runs", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+
+		values := []int{}
+		systemSet := SystemSet{}
+		err := systemSet.add(func(counter *Local[int]) {
+			values = append(values, *counter.Get())
+			*counter.Get()++
+		}, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+
+		for range 3 {
+			assert.Empty(systemSet.Exec(&world, nil))
+		}
+		assert.Equal([]int{0, 1, 2}, values)
+	})
+
+	t.Run("two registrations of the same system get independent state", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+
+		counters := []*Local[int]{}
+		system := func(counter *Local[int]) {
+			*counter.Get()++
+		}
+
+		systemSet := SystemSet{}
+		err := systemSet.add(system, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+		err = systemSet.add(Configure(system).RunIf(func() bool { return false }), &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+		for i := range systemSet.systems {
+			counters = append(counters, systemSet.systems[i].params[0].Interface().(*Local[int]))
+		}
+
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Equal(2, *counters[0].Get())
+		assert.Equal(0, *counters[1].Get())
+	})
+
+	t.Run("can hold a struct", func(t *testing.T) {
+		type timer struct {
+			ticks    int
+			finished bool
+		}
+
+		assert := assert.New(t)
+
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+
+		finished := false
+		systemSet := SystemSet{}
+		err := systemSet.add(func(local *Local[timer]) {
+			current := local.Get()
+			current.ticks++
+			if current.ticks == 2 {
+				local.Set(timer{ticks: current.ticks, finished: true})
+			}
+			finished = local.Get().finished
+		}, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.False(finished)
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.True(finished)
+	})
+
+	t.Run("returns an error when not used as a pointer", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+
+		systemSet := SystemSet{}
+		err := systemSet.add(func(_ Local[int]) {}, &world, nil, &logger, &resourceStorage)
+		assert.ErrorIs(err, ErrSystemParamNotAPointer)
+	})
+}
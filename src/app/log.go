@@ -1,6 +1,11 @@
 package app
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+)
 
 type Logger interface {
 	// Log a debug message
@@ -61,3 +66,41 @@ func (l *testLogger) Warn(message string) {
 func (l *testLogger) Error(message string) {
 	l.err++
 }
+
+// maxCollectedErrors is the maximum number of errors that errorCollectingLogger keeps.
+const maxCollectedErrors = 100
+
+// errorCollectingLogger passes all messages on to its Logger, and keeps the error messages so that they can be
+// returned when an App is done running.
+type errorCollectingLogger struct {
+	Logger
+	mutex                 sync.Mutex
+	errors                []error
+	numberOfDroppedErrors uint
+}
+
+func (l *errorCollectingLogger) Error(message string) {
+	l.Logger.Error(message)
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if len(l.errors) < maxCollectedErrors {
+		l.errors = append(l.errors, errors.New(message))
+	} else {
+		l.numberOfDroppedErrors++
+	}
+}
+
+// collectedErrors returns the errors that were logged.
+func (l *errorCollectingLogger) collectedErrors() []error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	result := slices.Clone(l.errors)
+	if l.numberOfDroppedErrors > 0 {
+		result = append(result, fmt.Errorf("%d more errors were logged", l.numberOfDroppedErrors))
+	}
+
+	return result
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"slices"
+	"sync"
 )
 
 type resourceId reflect.Type
@@ -12,11 +13,21 @@ type Resource any
 type resourceStorage struct {
 	resources            map[resourceId]Resource
 	blacklistedResources []resourceId // resources that may not be added to this resourceStorage
+	version              uint         // incremented on every change, so that systems know when to bind their resource params again
+	pending              *pendingResources
+}
+
+// pendingResources counts the resources that are going to be inserted by commands that are not applied yet. Commands
+// are recorded by systems that run in parallel, so it is guarded by a mutex.
+type pendingResources struct {
+	mutex  sync.Mutex
+	counts map[resourceId]uint
 }
 
 func newResourceStorage() resourceStorage {
 	return resourceStorage{
 		resources: map[resourceId]Resource{},
+		pending:   &pendingResources{counts: map[resourceId]uint{}},
 	}
 }
 
@@ -41,10 +52,96 @@ func (s *resourceStorage) add(resource Resource) error {
 	}
 
 	s.resources[resourceId] = resource
+	s.version++
 
 	return nil
 }
 
+// insertOrReplace adds resource, or replaces the resource of the same type if it is already present.
+//
+// Return an error if:
+//   - resource is not passed by reference
+//   - resource is blacklisted
+func (s *resourceStorage) insertOrReplace(resource Resource) error {
+	resourceType := reflect.TypeOf(resource)
+	if resourceType == nil || resourceType.Kind() != reflect.Pointer {
+		return ErrResourceNotAPointer
+	}
+
+	resourceId := reflectTypeToComponentId(resourceType)
+
+	if slices.Contains(s.blacklistedResources, resourceId) {
+		return fmt.Errorf("%w: blacklisted", ErrResourceTypeNotAllowed)
+	}
+
+	s.resources[resourceId] = resource
+	s.version++
+
+	return nil
+}
+
+// remove removes the resource of resourceType, regardless of wether resourceType is a pointer or not.
+//
+// Return an error if:
+//   - resource is blacklisted
+//   - resource is not present
+func (s *resourceStorage) remove(resourceType reflect.Type) error {
+	resourceId := reflectTypeToComponentId(resourceType)
+
+	if slices.Contains(s.blacklistedResources, resourceId) {
+		return fmt.Errorf("%w: blacklisted", ErrResourceTypeNotAllowed)
+	}
+
+	if _, exists := s.resources[resourceId]; !exists {
+		return ErrResourceNotFound
+	}
+
+	delete(s.resources, resourceId)
+	s.version++
+
+	return nil
+}
+
+// addPending records that a resource of resourceType is going to be inserted by a command.
+func (s *resourceStorage) addPending(resourceType reflect.Type) {
+	s.pending.mutex.Lock()
+	defer s.pending.mutex.Unlock()
+	s.pending.counts[reflectTypeToComponentId(resourceType)]++
+}
+
+// removePending records that a command that inserts a resource of resourceType is applied.
+func (s *resourceStorage) removePending(resourceType reflect.Type) {
+	s.pending.mutex.Lock()
+	defer s.pending.mutex.Unlock()
+
+	resourceId := reflectTypeToComponentId(resourceType)
+	if s.pending.counts[resourceId] <= 1 {
+		delete(s.pending.counts, resourceId)
+	} else {
+		s.pending.counts[resourceId]--
+	}
+}
+
+// isPending returns wether a resource of resourceType is going to be inserted by a command that is not applied yet.
+func (s *resourceStorage) isPending(resourceType reflect.Type) bool {
+	s.pending.mutex.Lock()
+	defer s.pending.mutex.Unlock()
+	return s.pending.counts[reflectTypeToComponentId(resourceType)] > 0
+}
+
+// numberOfUserResources returns the number of resources, without the reserved resources that were added before
+// they got blacklisted.
+func (s *resourceStorage) numberOfUserResources() uint {
+	result := uint(0)
+	for resourceId := range s.resources {
+		if !slices.Contains(s.blacklistedResources, resourceId) {
+			result++
+		}
+	}
+
+	return result
+}
+
 func registerBlacklistedResource[T Resource](storage *resourceStorage) error {
 	resourceType := reflect.TypeFor[T]()
 	return registerBlacklistedResourceType(resourceType, storage)
@@ -0,0 +1,164 @@
+package app
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// boundResourceParam is implemented by system params that hold on to a resource. They are bound again when resources
+// are inserted, replaced or removed while the app is running.
+type boundResourceParam interface {
+	bind(resources *resourceStorage) error
+	resourceType() reflect.Type // type of the resource that the param binds to
+}
+
+// Res is a system param that gives read-only access to the resource of type T, without copying it like using the
+// resource by value does. Systems that only use a resource with Res can run in parallel.
+//
+// Must be used as a pointer: *Res[T].
+//
+//	func render(settings *app.Res[Settings]) { ... settings.Get().Width ... }
+type Res[T any] struct {
+	value *T
+}
+
+func (res *Res[T]) init(_ *resourceStorage) error {
+	// bound by the system that uses it, which fails to be added if the resource is not present
+	return nil
+}
+
+func (res *Res[T]) bind(resources *resourceStorage) (err error) {
+	res.value, err = getResourceFromStorage[*T](resources)
+	if err != nil {
+		return fmt.Errorf("resource %s: %w", reflect.TypeFor[T]().String(), err)
+	}
+
+	return nil
+}
+
+func (res *Res[T]) resourceType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (res *Res[T]) access() []resourceAccess {
+	return []resourceAccess{{resourceId: reflectTypeToComponentId(reflect.TypeFor[T]())}}
+}
+
+// Get returns the resource. It must not be changed, because other systems might read it at the same time. Use
+// [ResMut] to change a resource.
+func (res *Res[T]) Get() *T {
+	return res.value
+}
+
+// ResMut is a system param that gives mutable access to the resource of type T. Systems that use the same resource
+// with ResMut, Res or by value do not run in parallel.
+//
+// Must be used as a pointer: *ResMut[T].
+//
+//	func resize(settings *app.ResMut[Settings]) { settings.Get().Width = 800 }
+type ResMut[T any] struct {
+	value *T
+}
+
+func (res *ResMut[T]) init(_ *resourceStorage) error {
+	// bound by the system that uses it, which fails to be added if the resource is not present
+	return nil
+}
+
+func (res *ResMut[T]) bind(resources *resourceStorage) (err error) {
+	res.value, err = getResourceFromStorage[*T](resources)
+	if err != nil {
+		return fmt.Errorf("resource %s: %w", reflect.TypeFor[T]().String(), err)
+	}
+
+	return nil
+}
+
+func (res *ResMut[T]) resourceType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (res *ResMut[T]) access() []resourceAccess {
+	return []resourceAccess{{resourceId: reflectTypeToComponentId(reflect.TypeFor[T]()), mutable: true}}
+}
+
+// Get returns the resource, which can be changed.
+func (res *ResMut[T]) Get() *T {
+	return res.value
+}
+
+// Option is a system param that gives read-only access to the resource of type T if it exists. Use [OptionMut] to
+// change the resource. Option is read-only, like Res, so that systems that only use a resource with Option or Res can
+// run in parallel.
+//
+// Option is the only way to use a resource that is optional. Other resource params require the resource: adding the
+// system fails if it is not present, and the system is skipped while it is removed. A system that uses Option can be
+// added when the resource does not exist and always runs. The resource is looked up on every call to Get, so that it
+// is picked up once it is added.
+//
+// Must be used as a pointer: *Option[T].
+//
+//	func drawDebugOverlay(overlay *app.Option[DebugOverlay]) {
+//		if overlay, ok := overlay.Get(); ok { ... }
+//	}
+type Option[T any] struct {
+	resources *resourceStorage
+}
+
+func (option *Option[T]) init(resources *resourceStorage) error {
+	option.resources = resources
+	return nil
+}
+
+func (option *Option[T]) access() []resourceAccess {
+	return []resourceAccess{{resourceId: reflectTypeToComponentId(reflect.TypeFor[T]())}}
+}
+
+// Get returns the resource and true if it exists, or nil and false if it does not. The resource must not be
+// changed, because other systems might read it at the same time. Use [OptionMut] to change a resource.
+func (option *Option[T]) Get() (*T, bool) {
+	return getOptionalResource[T](option.resources)
+}
+
+// IsPresent returns wether the resource exists.
+func (option *Option[T]) IsPresent() bool {
+	_, ok := option.Get()
+	return ok
+}
+
+// OptionMut is a system param that gives mutable access to the resource of type T if it exists. It is optional in the
+// same way as [Option]. Systems that use the same resource with OptionMut and any other param do not run in parallel.
+//
+// Must be used as a pointer: *OptionMut[T].
+type OptionMut[T any] struct {
+	resources *resourceStorage
+}
+
+func (option *OptionMut[T]) init(resources *resourceStorage) error {
+	option.resources = resources
+	return nil
+}
+
+func (option *OptionMut[T]) access() []resourceAccess {
+	return []resourceAccess{{resourceId: reflectTypeToComponentId(reflect.TypeFor[T]()), mutable: true}}
+}
+
+// Get returns the resource and true if it exists, or nil and false if it does not. The resource can be changed.
+func (option *OptionMut[T]) Get() (*T, bool) {
+	return getOptionalResource[T](option.resources)
+}
+
+// IsPresent returns wether the resource exists.
+func (option *OptionMut[T]) IsPresent() bool {
+	_, ok := option.Get()
+	return ok
+}
+
+func getOptionalResource[T any](resources *resourceStorage) (*T, bool) {
+	value, err := getResourceFromStorage[*T](resources)
+	if err != nil {
+		return nil, false
+	}
+
+	return value, true
+}
@@ -0,0 +1,129 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/lucdrenth/murphecs/src/ecs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceParams(t *testing.T) {
+	type settings struct {
+		width int
+	}
+
+	t.Run("Res gives the resource without copying it", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+		resource := &settings{width: 100}
+		assert.NoError(resourceStorage.add(resource))
+
+		var result *settings
+		systemSet := SystemSet{}
+		err := systemSet.add(func(res *Res[settings]) { result = res.Get() }, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Same(resource, result)
+	})
+
+	t.Run("ResMut can change the resource", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+		resource := &settings{width: 100}
+		assert.NoError(resourceStorage.add(resource))
+
+		systemSet := SystemSet{}
+		err := systemSet.add(func(res *ResMut[settings]) { res.Get().width = 200 }, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Equal(200, resource.width)
+	})
+
+	t.Run("returns an error if the resource does not exist", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+
+		systemSet := SystemSet{}
+		err := systemSet.add(func(_ *Res[settings]) {}, &world, nil, &logger, &resourceStorage)
+		assert.ErrorIs(err, ErrSystemParamNotValid)
+		assert.ErrorIs(err, ErrResourceNotFound)
+		err = systemSet.add(func(_ *ResMut[settings]) {}, &world, nil, &logger, &resourceStorage)
+		assert.ErrorIs(err, ErrSystemParamNotValid)
+		assert.ErrorIs(err, ErrResourceNotFound)
+	})
+
+	t.Run("returns an error if not used as a pointer", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+		assert.NoError(resourceStorage.add(&settings{}))
+
+		systemSet := SystemSet{}
+		err := systemSet.add(func(_ Res[settings]) {}, &world, nil, &logger, &resourceStorage)
+		assert.ErrorIs(err, ErrSystemParamNotAPointer)
+	})
+
+	t.Run("Option and OptionMut are absent if the resource does not exist and pick it up once it is added", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+
+		var readResult, mutResult *settings
+		isReadPresent, isMutPresent := false, false
+		systemSet := SystemSet{}
+		err := systemSet.add(func(option *Option[settings]) {
+			readResult, isReadPresent = option.Get()
+		}, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+		err = systemSet.add(func(option *OptionMut[settings]) {
+			mutResult, isMutPresent = option.Get()
+		}, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.False(isReadPresent)
+		assert.False(isMutPresent)
+		assert.Nil(readResult)
+		assert.Nil(mutResult)
+
+		resource := &settings{width: 100}
+		assert.NoError(resourceStorage.add(resource))
+
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.True(isReadPresent)
+		assert.True(isMutPresent)
+		assert.Same(resource, readResult)
+		assert.Same(resource, mutResult)
+	})
+
+	t.Run("Option runs in parallel with other readers of the resource", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+		assert.NoError(resourceStorage.add(&settings{}))
+
+		systemSet := SystemSet{}
+		assert.NoError(systemSet.add(func(_ *Option[settings]) {}, &world, nil, &logger, &resourceStorage))
+		assert.NoError(systemSet.add(func(_ *Res[settings]) {}, &world, nil, &logger, &resourceStorage))
+		assert.NoError(systemSet.add(func(_ *OptionMut[settings]) {}, &world, nil, &logger, &resourceStorage))
+		assert.NoError(systemSet.add(func(_ *Option[settings]) {}, &world, nil, &logger, &resourceStorage))
+		assert.Equal([][]int{{0, 1}, {2}, {3}}, systemSet.stages)
+	})
+}
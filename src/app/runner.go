@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -11,10 +12,19 @@ type Runner interface {
 	Run(exitChannel <-chan struct{}, systems []*SystemSet)
 }
 
+// ContextRunner is a Runner that can be stopped by a context. When an app is run, RunContext is used instead of Run
+// for runners that implement it. The error that it returns is returned by [SubApp.RunContext].
+type ContextRunner interface {
+	Runner
+	RunContext(ctx context.Context, systems []*SystemSet) error
+}
+
+var _ ContextRunner = (*fixedRunner)(nil)
+var _ ContextRunner = (*fixedTimestepRunner)(nil)
+
 // FixedRunner runs systems at a fixed interval
 type fixedRunner struct {
 	tickRate    *time.Duration
-	delta       *float64
 	world       *ecs.World
 	outerWorlds *map[ecs.WorldId]*ecs.World
 	logger      Logger
@@ -24,8 +34,6 @@ type fixedRunner struct {
 func (runner *fixedRunner) Run(exitChannel <-chan struct{}, systems []*SystemSet) {
 	ticker := time.NewTicker(*runner.tickRate)
 	currentTickRate := *runner.tickRate
-	var now int64
-	start := time.Now().UnixNano()
 
 	for {
 		select {
@@ -33,10 +41,6 @@ func (runner *fixedRunner) Run(exitChannel <-chan struct{}, systems []*SystemSet
 			return
 
 		case <-ticker.C:
-			now = time.Now().UnixNano()
-			*runner.delta = float64(now-start) / 1_000_000_000
-			start = now
-
 			runSystemSet(systems, runner.world, runner.outerWorlds, runner.logger, runner.appName)
 
 			if currentTickRate != *runner.tickRate {
@@ -47,6 +51,119 @@ func (runner *fixedRunner) Run(exitChannel <-chan struct{}, systems []*SystemSet
 	}
 }
 
+func (runner *fixedRunner) RunContext(ctx context.Context, systems []*SystemSet) error {
+	runner.Run(ctx.Done(), systems)
+	return nil
+}
+
+// frameRunner is a Runner that runs the frame schedules itself. Runners that are not a frameRunner run the frame
+// schedules after each run of the repeated schedules. tickedFrameSystems run after the frame systems, but only in
+// frames in which the repeated schedules ran at least once.
+type frameRunner interface {
+	setFrameSystems(frameSystems []*SystemSet, tickedFrameSystems []*SystemSet)
+}
+
+// FixedTimestep is a resource that holds the timing of the fixed-timestep runner. It can be used by frame
+// schedules to interpolate between the last two ticks of the repeated schedules.
+type FixedTimestep struct {
+	step  time.Duration
+	alpha float64
+}
+
+// Step returns the amount of time that is simulated by each tick of the repeated schedules.
+func (t *FixedTimestep) Step() time.Duration {
+	return t.step
+}
+
+// Alpha returns how far the current frame is between the last tick and the next tick of the repeated schedules,
+// from 0 (at the last tick) up to 1 (at the next tick).
+func (t *FixedTimestep) Alpha() float64 {
+	return t.alpha
+}
+
+// fixedTimestepRunner runs the repeated systems once for every tickRate of time that has passed on the clock of the
+// app, as measured at the start of each frame. Time that is not yet simulated is kept in an accumulator, so that no time is lost after a
+// slow frame. After the repeated systems have caught up, the frame systems run once.
+type fixedTimestepRunner struct {
+	tickRate           *time.Duration
+	frameRate          time.Duration
+	maxTicksPerFrame   uint // the maximum number of ticks to catch up on in a single frame
+	accumulator        time.Duration
+	timestep           *FixedTimestep
+	world              *ecs.World
+	outerWorlds        *map[ecs.WorldId]*ecs.World
+	logger             Logger
+	appName            string
+	frameSystems       []*SystemSet
+	tickedFrameSystems []*SystemSet // run after frameSystems in frames in which at least one tick ran
+	clock              *Clock       // clock of the app, which is used to measure the elapsed time of each frame
+	lastFrame          time.Time    // time on clock at the start of the previous frame
+}
+
+func (runner *fixedTimestepRunner) setFrameSystems(frameSystems []*SystemSet, tickedFrameSystems []*SystemSet) {
+	runner.frameSystems = frameSystems
+	runner.tickedFrameSystems = tickedFrameSystems
+}
+
+func (runner *fixedTimestepRunner) simulatedDelta() time.Duration {
+	return *runner.tickRate
+}
+
+func (runner *fixedTimestepRunner) Run(exitChannel <-chan struct{}, systems []*SystemSet) {
+	ticker := time.NewTicker(runner.frameRate)
+	defer ticker.Stop()
+	runner.lastFrame = (*runner.clock).Now()
+
+	for {
+		select {
+		case <-exitChannel:
+			return
+
+		case <-ticker.C:
+			runner.nextFrame(systems)
+		}
+	}
+}
+
+// nextFrame runs a frame for the time that passed on the clock since the previous frame.
+func (runner *fixedTimestepRunner) nextFrame(systems []*SystemSet) {
+	now := (*runner.clock).Now()
+	runner.frame(now.Sub(runner.lastFrame), systems)
+	runner.lastFrame = now
+}
+
+func (runner *fixedTimestepRunner) RunContext(ctx context.Context, systems []*SystemSet) error {
+	runner.Run(ctx.Done(), systems)
+	return nil
+}
+
+// frame adds elapsed to the accumulator and runs the repeated systems as many times as needed to catch up, up to
+// maxTicksPerFrame. If it can not catch up, the remaining whole ticks are dropped. The ticked frame systems only run
+// if at least one tick ran.
+func (runner *fixedTimestepRunner) frame(elapsed time.Duration, systems []*SystemSet) {
+	step := *runner.tickRate
+	runner.accumulator += elapsed
+
+	ticks := uint(0)
+	for ; runner.accumulator >= step && ticks < runner.maxTicksPerFrame; ticks++ {
+		runSystemSet(systems, runner.world, runner.outerWorlds, runner.logger, runner.appName)
+		runner.accumulator -= step
+	}
+
+	if runner.accumulator >= step {
+		runner.logger.Warn(fmt.Sprintf("%s - fixed timestep runner is %d ticks behind, dropping them", runner.appName, runner.accumulator/step))
+		runner.accumulator %= step
+	}
+
+	runner.timestep.step = step
+	runner.timestep.alpha = float64(runner.accumulator) / float64(step)
+	runSystemSet(runner.frameSystems, runner.world, runner.outerWorlds, runner.logger, runner.appName)
+
+	if ticks > 0 {
+		runSystemSet(runner.tickedFrameSystems, runner.world, runner.outerWorlds, runner.logger, runner.appName)
+	}
+}
+
 // onceRunner runs systems once and then return
 type onceRunner struct {
 	world       *ecs.World
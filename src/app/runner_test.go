@@ -0,0 +1,126 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lucdrenth/murphecs/src/ecs"
+	"github.com/lucdrenth/murphecs/src/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixedTimestepRunner(t *testing.T) {
+	newRunner := func(ticks *int, alphas *[]float64) (*fixedTimestepRunner, []*SystemSet) {
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+		timestep := &FixedTimestep{}
+		err := resourceStorage.add(timestep)
+		if err != nil {
+			panic(err)
+		}
+
+		fixedSystems := &SystemSet{}
+		err = fixedSystems.add(func() { *ticks++ }, &world, nil, &logger, &resourceStorage)
+		if err != nil {
+			panic(err)
+		}
+
+		frameSystems := &SystemSet{}
+		err = frameSystems.add(func(timestep FixedTimestep) { *alphas = append(*alphas, timestep.Alpha()) }, &world, nil, &logger, &resourceStorage)
+		if err != nil {
+			panic(err)
+		}
+
+		runner := &fixedTimestepRunner{
+			tickRate:         utils.PointerTo(10 * time.Millisecond),
+			maxTicksPerFrame: 3,
+			timestep:         timestep,
+			world:            &world,
+			logger:           &logger,
+		}
+		runner.setFrameSystems([]*SystemSet{frameSystems}, nil)
+
+		return runner, []*SystemSet{fixedSystems}
+	}
+
+	t.Run("runs a tick for every step of elapsed time", func(t *testing.T) {
+		assert := assert.New(t)
+
+		ticks, alphas := 0, []float64{}
+		runner, systems := newRunner(&ticks, &alphas)
+
+		runner.frame(25*time.Millisecond, systems)
+		assert.Equal(2, ticks)
+		assert.InDelta(0.5, alphas[0], 0.0001)
+
+		runner.frame(5*time.Millisecond, systems)
+		assert.Equal(3, ticks)
+		assert.InDelta(0.0, alphas[1], 0.0001)
+
+		runner.frame(4*time.Millisecond, systems)
+		assert.Equal(3, ticks)
+		assert.InDelta(0.4, alphas[2], 0.0001)
+	})
+
+	t.Run("keeps the remaining time after a slow frame", func(t *testing.T) {
+		assert := assert.New(t)
+
+		ticks, alphas := 0, []float64{}
+		runner, systems := newRunner(&ticks, &alphas)
+
+		runner.frame(37*time.Millisecond, systems)
+		runner.frame(3*time.Millisecond, systems)
+		assert.Equal(4, ticks)
+		assert.Equal(time.Duration(0), runner.accumulator)
+	})
+
+	t.Run("does not catch up on more than the maximum ticks per frame", func(t *testing.T) {
+		assert := assert.New(t)
+
+		ticks, alphas := 0, []float64{}
+		runner, systems := newRunner(&ticks, &alphas)
+
+		runner.frame(105*time.Millisecond, systems)
+		assert.Equal(3, ticks)
+		assert.InDelta(0.5, alphas[0], 0.0001)
+
+		runner.frame(0, systems)
+		assert.Equal(3, ticks)
+	})
+
+	t.Run("simulates the step as delta", func(t *testing.T) {
+		assert := assert.New(t)
+
+		ticks, alphas := 0, []float64{}
+		runner, systems := newRunner(&ticks, &alphas)
+		appTime := newTime()
+		systems = append([]*SystemSet{newUpdateTimeSystemSet(appTime, realClock{}, runner)}, systems...)
+
+		runner.frame(23*time.Millisecond, systems)
+		assert.Equal(10*time.Millisecond, appTime.Delta())
+		assert.Equal(20*time.Millisecond, appTime.Elapsed())
+	})
+	t.Run("measures the elapsed time of each frame on the clock of the app", func(t *testing.T) {
+		assert := assert.New(t)
+
+		ticks, alphas := 0, []float64{}
+		runner, systems := newRunner(&ticks, &alphas)
+		clock := NewVirtualClock(time.Now())
+		var appClock Clock = clock
+		runner.clock = &appClock
+		runner.lastFrame = clock.Now()
+
+		clock.Advance(35 * time.Millisecond)
+		runner.nextFrame(systems)
+		assert.Equal(3, ticks)
+
+		runner.nextFrame(systems)
+		assert.Equal(3, ticks)
+
+		clock.Advance(5 * time.Millisecond)
+		runner.nextFrame(systems)
+		assert.Equal(4, ticks)
+		assert.InDelta(0.0, alphas[2], 0.0001)
+	})
+}
@@ -40,6 +40,17 @@ func (s *Scheduler) AddSystem(schedule Schedule, system System, world *ecs.World
 	return systemSet.add(system, world, outerWorlds, logger, resources)
 }
 
+// AddRunCondition adds a condition to schedule. The systems of schedule only run on ticks where all conditions of
+// the schedule return true.
+func (s *Scheduler) AddRunCondition(schedule Schedule, condition RunCondition, world *ecs.World, outerWorlds *map[ecs.WorldId]*ecs.World, logger Logger, resources *resourceStorage) error {
+	systemSet, exists := s.systems[schedule]
+	if !exists {
+		return fmt.Errorf("schedule %s does not exist", schedule)
+	}
+
+	return systemSet.addRunCondition(condition, world, outerWorlds, logger, resources)
+}
+
 func (s *Scheduler) GetSystemSets() ([]*SystemSet, error) {
 	if len(s.order) != len(s.systems) {
 		return nil, fmt.Errorf("order of length %d does not match schedules of length %d", len(s.order), len(s.systems))
@@ -53,12 +64,31 @@ func (s *Scheduler) GetSystemSets() ([]*SystemSet, error) {
 			return nil, fmt.Errorf("schedule %s from schedule order does not exist", schedule)
 		}
 
+		if err := systemSet.sortSystems(); err != nil {
+			return nil, fmt.Errorf("failed to order systems of schedule %s: %w", schedule, err)
+		}
+
 		result[i] = systemSet
 	}
 
 	return result, nil
 }
 
+// GetSystemSetsBySchedule returns the ordered system sets of all schedules by their schedule.
+func (s *Scheduler) GetSystemSetsBySchedule() (map[Schedule]*SystemSet, error) {
+	systemSets, err := s.GetSystemSets()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[Schedule]*SystemSet, len(systemSets))
+	for i, schedule := range s.order {
+		result[schedule] = systemSets[i]
+	}
+
+	return result, nil
+}
+
 func (s *Scheduler) NumberOfSystems() uint {
 	result := uint(0)
 	for _, systems := range s.systems {
@@ -0,0 +1,180 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/lucdrenth/murphecs/src/ecs"
+)
+
+// State is a resource that holds the current value of a state machine. State machines are added with [AddState]
+// and can be changed by using [NextState].
+//
+// Use it as a system param to read the current state:
+//
+//	func system(state app.State[GameState]) { ... }
+type State[T comparable] struct {
+	current T
+}
+
+// Get returns the current value of the state.
+func (state *State[T]) Get() T {
+	return state.current
+}
+
+// NextState is a resource that is used to request a transition of a state machine. The transition is done at the
+// start of the next tick, before any of the repeated systems run.
+//
+// Use it as a system param by reference:
+//
+//	func system(next *app.NextState[GameState]) { next.Set(Playing) }
+type NextState[T comparable] struct {
+	next  T
+	isSet bool
+}
+
+// Set requests a transition to state. If Set is called multiple times during a tick, the last one wins.
+func (next *NextState[T]) Set(state T) {
+	next.next = state
+	next.isSet = true
+}
+
+// take returns the requested state and wether a state was requested, and clears the request.
+func (next *NextState[T]) take() (T, bool) {
+	state, isSet := next.next, next.isSet
+
+	var zero T
+	next.next = zero
+	next.isSet = false
+
+	return state, isSet
+}
+
+const (
+	onEnterSchedulePrefix = "OnEnter"
+	onExitSchedulePrefix  = "OnExit"
+	inStateSchedulePrefix = "InState"
+)
+
+// OnEnter returns the schedule that runs once when the state machine of T transitions to state. The schedule of
+// the initial state runs once after the startup schedules.
+func OnEnter[T comparable](state T) Schedule {
+	return stateSchedule(onEnterSchedulePrefix, state)
+}
+
+// OnExit returns the schedule that runs once when the state machine of T transitions away from state.
+func OnExit[T comparable](state T) Schedule {
+	return stateSchedule(onExitSchedulePrefix, state)
+}
+
+// InState returns the schedule that runs every tick while the state machine of T is in state. It runs after the
+// state transitions and before the other repeated schedules.
+func InState[T comparable](state T) Schedule {
+	return stateSchedule(inStateSchedulePrefix, state)
+}
+
+func stateSchedule(prefix string, state any) Schedule {
+	return Schedule(fmt.Sprintf("%s[%s](%#v)", prefix, reflect.TypeOf(state).String(), state))
+}
+
+// isStateSchedule returns wether schedule was created by OnEnter, OnExit or InState.
+func isStateSchedule(schedule Schedule) bool {
+	for _, prefix := range []string{onEnterSchedulePrefix, onExitSchedulePrefix, inStateSchedulePrefix} {
+		if strings.HasPrefix(string(schedule), prefix+"[") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// stateMachine transitions a state and runs its state schedules.
+type stateMachine interface {
+	// exec transitions the state if a transition was requested, and runs the InState schedule of the current
+	// state afterwards.
+	exec(schedules map[Schedule]*SystemSet, world *ecs.World, outerWorlds *map[ecs.WorldId]*ecs.World) []error
+	stateType() reflect.Type
+}
+
+type stateTransitions[T comparable] struct {
+	state      *State[T]
+	next       *NextState[T]
+	hasEntered bool // wether OnEnter of the initial state has run
+}
+
+func (s *stateTransitions[T]) exec(schedules map[Schedule]*SystemSet, world *ecs.World, outerWorlds *map[ecs.WorldId]*ecs.World) []error {
+	errs := []error{}
+	runSchedule := func(schedule Schedule) {
+		if systemSet, ok := schedules[schedule]; ok {
+			// the worlds are already locked by the state system set
+			errs = append(errs, systemSet.exec(world, outerWorlds)...)
+		}
+	}
+
+	if !s.hasEntered {
+		s.hasEntered = true
+		runSchedule(OnEnter(s.state.current))
+	}
+
+	// Transitioning to the current state does nothing.
+	if next, isSet := s.next.take(); isSet && next != s.state.current {
+		runSchedule(OnExit(s.state.current))
+		s.state.current = next
+		runSchedule(OnEnter(s.state.current))
+	}
+
+	runSchedule(InState(s.state.current))
+
+	return errs
+}
+
+func (s *stateTransitions[T]) stateType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+// AddState adds a state machine for T to app, with initial as its current state. This adds the [State] and
+// [NextState] resources of T, and makes the app run the [OnEnter], [OnExit] and [InState] schedules of T.
+//
+// Systems can be added to the state schedules without adding the schedules first:
+//
+//	app.AddState(&myApp, MainMenu)
+//	myApp.AddSystem(app.OnEnter(MainMenu), spawnMenu)
+func AddState[T comparable](app *SubApp, initial T) *SubApp {
+	for _, machine := range app.states {
+		if machine.stateType() == reflect.TypeFor[T]() {
+			app.logger.Error(fmt.Sprintf("%s - failed to add state %s: %v", app.name, reflect.TypeFor[T]().String(), ErrStateAlreadyPresent))
+			return app
+		}
+	}
+
+	machine := &stateTransitions[T]{
+		state: &State[T]{current: initial},
+		next:  &NextState[T]{},
+	}
+
+	app.AddResource(machine.state)
+	app.AddResource(machine.next)
+	app.states = append(app.states, machine)
+
+	return app
+}
+
+// newStateSystemSet returns a SystemSet that runs the state transitions and the state schedules of machines.
+func newStateSystemSet(machines []stateMachine, schedules map[Schedule]*SystemSet, world *ecs.World, outerWorlds *map[ecs.WorldId]*ecs.World) *SystemSet {
+	systemSet := &SystemSet{}
+	systemSet.addEntry(systemEntry{system: reflect.ValueOf(func() error {
+		errs := []error{}
+		for _, machine := range machines {
+			errs = append(errs, machine.exec(schedules, world, outerWorlds)...)
+		}
+		return errors.Join(errs...)
+	})}, 0)
+
+	for _, nested := range schedules {
+		systemSet.nested = append(systemSet.nested, nested)
+	}
+
+	return systemSet
+}
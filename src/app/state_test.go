@@ -0,0 +1,139 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/lucdrenth/murphecs/src/ecs"
+	"github.com/stretchr/testify/assert"
+)
+
+func runApp(app *SubApp, ticks uint) {
+	app.Startup()
+	app.Step(ticks)
+	app.Shutdown()
+}
+
+func TestState(t *testing.T) {
+	type gameState int
+	const (
+		menu gameState = iota
+		playing
+	)
+
+	t.Run("runs OnEnter of the initial state once", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+
+		counter := 0
+		AddState(&app, menu)
+		app.AddSystem(OnEnter(menu), func() { counter++ })
+
+		runApp(&app, 3)
+		assert.Equal(uint(0), logger.err)
+		assert.Equal(1, counter)
+	})
+
+	t.Run("runs OnExit and OnEnter when transitioning", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+
+		order := []string{}
+		AddState(&app, menu)
+		app.AddSchedule(testSchedule, ScheduleTypeRepeating)
+		app.AddSystem(testSchedule, func(next *NextState[gameState]) { next.Set(playing) })
+		app.AddSystem(OnEnter(menu), func() { order = append(order, "enter menu") })
+		app.AddSystem(OnExit(menu), func() { order = append(order, "exit menu") })
+		app.AddSystem(OnEnter(playing), func(state State[gameState]) {
+			assert.Equal(playing, state.Get())
+			order = append(order, "enter playing")
+		})
+		app.AddSystem(OnExit(playing), func() { order = append(order, "exit playing") })
+
+		runApp(&app, 3)
+		assert.Equal(uint(0), logger.err)
+		assert.Equal([]string{"enter menu", "exit menu", "enter playing"}, order)
+	})
+
+	t.Run("runs InState every tick while in that state", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+
+		menuTicks, playingTicks := 0, 0
+		AddState(&app, menu)
+		app.AddSystem(InState(menu), func(next *NextState[gameState]) {
+			menuTicks++
+			if menuTicks == 2 {
+				next.Set(playing)
+			}
+		})
+		app.AddSystem(InState(playing), func() { playingTicks++ })
+
+		runApp(&app, 5)
+		assert.Equal(uint(0), logger.err)
+		assert.Equal(2, menuTicks)
+		assert.Equal(3, playingTicks)
+	})
+
+	t.Run("transitioning to the current state does nothing", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+
+		counter := 0
+		AddState(&app, menu)
+		app.AddSchedule(testSchedule, ScheduleTypeRepeating)
+		app.AddSystem(testSchedule, func(next *NextState[gameState]) { next.Set(menu) })
+		app.AddSystem(OnExit(menu), func() { counter++ })
+
+		runApp(&app, 3)
+		assert.Equal(uint(0), logger.err)
+		assert.Equal(0, counter)
+	})
+
+	t.Run("logs an error when adding the same state twice", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+
+		AddState(&app, menu)
+		AddState(&app, playing)
+		assert.Equal(uint(1), logger.err)
+	})
+
+	t.Run("logs an error when adding a schedule with the state schedule type", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+
+		app.AddSchedule(testSchedule, scheduleTypeState)
+		assert.Equal(uint(1), logger.err)
+	})
+
+	t.Run("state schedules of different values and types are unique", func(t *testing.T) {
+		assert := assert.New(t)
+
+		type otherState int
+
+		assert.NotEqual(OnEnter(menu), OnEnter(playing))
+		assert.NotEqual(OnEnter(menu), OnExit(menu))
+		assert.NotEqual(OnEnter(menu), InState(menu))
+		assert.NotEqual(OnEnter(menu), OnEnter(otherState(0)))
+		assert.True(isStateSchedule(InState(menu)))
+		assert.False(isStateSchedule(testSchedule))
+	})
+}
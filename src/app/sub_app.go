@@ -1,8 +1,11 @@
 package app
 
 import (
+	"context"
 	"fmt"
+	"reflect"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/lucdrenth/murphecs/src/ecs"
@@ -15,6 +18,8 @@ const (
 	ScheduleTypeStartup   scheduleType = iota // run only once, on startup
 	ScheduleTypeRepeating                     // runs repeatedly, in the main loop
 	ScheduleTypeCleanup                       // runs only once, before quitting
+	ScheduleTypeFrame                         // runs once per frame, after the repeated schedules. See SetFixedTimestepRunner
+	scheduleTypeState                         // runs around state transitions, see AddState
 )
 
 // SubApp has startup systems, repeating systems and cleanup systems.
@@ -27,12 +32,24 @@ type SubApp struct {
 	world       ecs.World
 	schedules   map[scheduleType]*Scheduler
 	resources   resourceStorage // resources that can be pulled by system params.
-	logger      Logger
+	logger      *errorCollectingLogger
 	name        string
 	tickRate    *time.Duration // the rate at which the repeating systems run
-	lastDelta   *float64       // delta time of the last tick
-	runner      Runner
+	time        *Time
+	exit        *Exit
+	context     *runContext // context that is passed to systems
+	runner      Runner      // nil until a runner is set or until the app runs, see SetFixedRunner
 	outerWorlds map[ecs.WorldId]*ecs.World
+	states      []stateMachine
+	clock       Clock       // clock that is used to measure the time that passes between ticks
+	stepped     *steppedApp // state of manual stepping, nil if the app is not started up by Startup
+
+	featureTypes       map[reflect.Type]bool // types of the features that were added
+	unfinishedFeatures []IFeature            // features that were added but on which Finish is not called yet
+}
+
+type steppedApp struct {
+	systems appSystems
 }
 
 func New(logger Logger, worldConfigs ecs.WorldConfigs) (SubApp, error) {
@@ -54,52 +71,106 @@ func New(logger Logger, worldConfigs ecs.WorldConfigs) (SubApp, error) {
 	// tries to add them.
 	registerBlacklistedResource[*ecs.World](&resourceStorage)
 
+	// Time, Exit and the context of system params are reserved as well, but are fetched from the storage like any
+	// other resource. They are added before they are blacklisted so that only the user is prevented from adding them.
+	appTime := newTime()
+	resourceStorage.add(appTime)
+	registerBlacklistedResource[*Time](&resourceStorage)
+	exit := newExit()
+	resourceStorage.add(exit)
+	registerBlacklistedResource[*Exit](&resourceStorage)
+	systemContext := newRunContext()
+	resourceStorage.add(systemContext)
+	registerBlacklistedResource[*runContext](&resourceStorage)
+
 	subApp := SubApp{
 		world: world,
 		schedules: map[scheduleType]*Scheduler{
 			ScheduleTypeStartup:   utils.PointerTo(NewScheduler()),
 			ScheduleTypeRepeating: utils.PointerTo(NewScheduler()),
 			ScheduleTypeCleanup:   utils.PointerTo(NewScheduler()),
+			ScheduleTypeFrame:     utils.PointerTo(NewScheduler()),
+			scheduleTypeState:     utils.PointerTo(NewScheduler()),
 		},
 		resources:   resourceStorage,
-		logger:      logger,
+		logger:      &errorCollectingLogger{Logger: logger},
 		name:        "App",
 		tickRate:    utils.PointerTo(time.Second / 60.0),
-		lastDelta:   utils.PointerTo(0.0),
+		time:        appTime,
+		exit:        exit,
+		context:     systemContext,
 		outerWorlds: map[ecs.WorldId]*ecs.World{},
-	}
-	subApp.SetFixedRunner()
+		clock:       realClock{},
 
+		featureTypes: map[reflect.Type]bool{},
+	}
 	return subApp, nil
 }
 
 func (app *SubApp) AddSystem(schedule Schedule, system System) *SubApp {
+	scheduler := app.getScheduler(schedule)
+	if scheduler == nil {
+		app.logger.Error(fmt.Sprintf("%s - failed to add system %s: schedule %s not found",
+			app.name,
+			systemToDebugString(system),
+			schedule,
+		))
+		return app
+	}
+
+	err := scheduler.AddSystem(schedule, system, &app.world, &app.outerWorlds, app.logger, &app.resources)
+	if err != nil {
+		app.logger.Error(fmt.Sprintf("%s - failed to add system %s: %v",
+			app.name,
+			systemToDebugString(system),
+			err,
+		))
+	}
+
+	return app
+}
+
+// RunScheduleIf makes the systems of schedule only run on ticks where condition returns true. The params of
+// condition are resolved the same way as the params of a system.
+func (app *SubApp) RunScheduleIf(schedule Schedule, condition RunCondition) *SubApp {
+	scheduler := app.getScheduler(schedule)
+	if scheduler == nil {
+		app.logger.Error(fmt.Sprintf("%s - failed to add run condition: schedule %s not found", app.name, schedule))
+		return app
+	}
+
+	err := scheduler.AddRunCondition(schedule, condition, &app.world, &app.outerWorlds, app.logger, &app.resources)
+	if err != nil {
+		app.logger.Error(fmt.Sprintf("%s - failed to add run condition to schedule %s: %v", app.name, schedule, err))
+	}
+
+	return app
+}
+
+// getScheduler returns the scheduler that contains schedule, or nil if there is none. State schedules do not have
+// to be added by the user, so they are added to the state scheduler the first time they are used.
+func (app *SubApp) getScheduler(schedule Schedule) *Scheduler {
 	for _, scheduler := range app.schedules {
 		if slices.Contains(scheduler.order, schedule) {
-			err := scheduler.AddSystem(schedule, system, &app.world, &app.outerWorlds, app.logger, &app.resources)
-			if err != nil {
-				app.logger.Error(fmt.Sprintf("%s - failed to add system %s: %v",
-					app.name,
-					systemToDebugString(system),
-					err,
-				))
-			}
-
-			return app
+			return scheduler
 		}
 	}
 
-	app.logger.Error(fmt.Sprintf("%s - failed to add system %s: schedule %s not found",
-		app.name,
-		systemToDebugString(system),
-		schedule,
-	))
-	return app
+	if !isStateSchedule(schedule) {
+		return nil
+	}
+
+	scheduler := app.schedules[scheduleTypeState]
+	if err := scheduler.AddSchedule(schedule); err != nil {
+		return nil
+	}
+
+	return scheduler
 }
 
 func (app *SubApp) AddSchedule(schedule Schedule, scheduleType scheduleType) *SubApp {
 	scheduler, ok := app.schedules[scheduleType]
-	if !ok {
+	if !ok || scheduleType == scheduleTypeState {
 		app.logger.Error(fmt.Sprintf("%s - failed to add schedule %s: invalid schedule type", app.name, schedule))
 		return app
 	}
@@ -121,75 +192,317 @@ func (app *SubApp) AddResource(resource Resource) *SubApp {
 	return app
 }
 
+// AddFeature adds feature and its nested features, of which the types are not added yet, in the order of their
+// dependencies. First the resources of all these features are added, then [FeatureBuilder.Build] is called and
+// then their systems are added. A feature is only marked as added once it is built, so a feature of which Build
+// returns an error can be added again. The features that depend on it, as nested feature or with
+// [FeatureDependsOn], are skipped and reported in the error. [FeatureFinisher.Finish] is called right before the app
+// runs.
+//
+// Errors are logged. If the dependencies of the features can not be resolved, none of them are added.
 func (app *SubApp) AddFeature(feature IFeature) *SubApp {
-	feature.Init()
-	features := feature.GetAndInitNestedFeatures()
-	features = append(features, feature)
+	features, err := app.resolveFeatures(feature)
+	if err != nil {
+		app.logger.Error(fmt.Sprintf("%s - failed to add feature %s: %v", app.name, featureDebugType(feature), err))
+		return app
+	}
 
-	validatedFeatures := make([]IFeature, 0, len(features))
-	for _, feature := range features {
-		err := validateFeature(feature)
-		if err != nil {
-			app.logger.Error(fmt.Sprintf("%s - %v", app.name, err))
-			continue
+	// resources that were added per feature, so that they can be removed again if the feature fails to build
+	addedResources := make([][]Resource, len(features))
+	for i, feature := range features {
+		for _, resource := range feature.GetResources() {
+			if err := app.resources.add(resource); err != nil {
+				app.logger.Error(fmt.Sprintf("%s - failed to add resource %s: %v", app.name, getResourceDebugType(resource), err))
+				continue
+			}
+			addedResources[i] = append(addedResources[i], resource)
 		}
-
-		validatedFeatures = append(validatedFeatures, feature)
 	}
 
-	for _, feature := range validatedFeatures {
-		resources := feature.GetResources()
-		for i := range resources {
-			app.AddResource(resources[i])
+	// failedBy maps the type of every feature that failed to build, or that depends on such a feature, to the type
+	// of the feature that failed to build
+	failedBy := map[reflect.Type]reflect.Type{}
+	buildErrors := map[reflect.Type]error{}
+	skipped := map[reflect.Type][]string{}
+	failedOrder := []IFeature{}
+
+	for i, feature := range features {
+		featureType := reflect.TypeOf(feature)
+
+		if root, ok := findFailedDependency(feature, failedBy); ok {
+			failedBy[featureType] = root
+			skipped[root] = append(skipped[root], featureDebugType(feature))
+			app.removeFeatureResources(addedResources[i])
+			continue
+		}
+
+		if builder, ok := feature.(FeatureBuilder); ok {
+			if err := builder.Build(app); err != nil {
+				failedBy[featureType] = featureType
+				buildErrors[featureType] = err
+				failedOrder = append(failedOrder, feature)
+				app.removeFeatureResources(addedResources[i])
+				continue
+			}
 		}
-	}
 
-	for _, feature := range validatedFeatures {
+		app.featureTypes[featureType] = true
+		app.unfinishedFeatures = append(app.unfinishedFeatures, feature)
+
 		systems := feature.GetSystems()
 		for i := range systems {
 			app.AddSystem(systems[i].schedule, systems[i].system)
 		}
 	}
 
+	for _, feature := range failedOrder {
+		featureType := reflect.TypeOf(feature)
+		message := fmt.Sprintf("%s - failed to build feature %s: %v", app.name, featureDebugType(feature), buildErrors[featureType])
+		if len(skipped[featureType]) > 0 {
+			message += fmt.Sprintf(", skipped the features that depend on it: %s", strings.Join(skipped[featureType], ", "))
+		}
+		app.logger.Error(message)
+	}
+
 	return app
 }
 
+func (app *SubApp) removeFeatureResources(resources []Resource) {
+	for _, resource := range resources {
+		if err := app.resources.remove(reflect.TypeOf(resource)); err != nil {
+			app.logger.Error(fmt.Sprintf("%s - failed to remove resource %s: %v", app.name, getResourceDebugType(resource), err))
+		}
+	}
+}
+
+// HasFeature returns wether a feature of type T was added.
+func HasFeature[T IFeature](app *SubApp) bool {
+	return app.featureTypes[reflect.TypeFor[T]()]
+}
+
+// Run runs the app until exitChannel is closed or until an exit is requested with the [Exit] resource. Afterwards,
+// true is sent to isDoneChannel. See RunContext.
 func (app *SubApp) Run(exitChannel <-chan struct{}, isDoneChannel chan<- bool) {
+	defer func() { isDoneChannel <- true }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-exitChannel:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	if err := app.RunContext(ctx); err != nil {
+		app.logger.Error(fmt.Sprintf("%s - %v", app.name, err))
+	}
+}
+
+// RunContext runs the startup schedules, then runs the repeated schedules until ctx is done or until an exit is
+// requested with the [Exit] resource, and then runs the cleanup schedules.
+//
+// Systems can get the context as a context.Context system param, so that they can stop promptly. The cleanup
+// systems get a context that is not canceled, so that they can still finish their work.
+//
+// Returns an error if the app could not be run, or the error of the runner if it is a [ContextRunner]. The cleanup
+// schedules are run regardless of wether the runner returned an error.
+func (app *SubApp) RunContext(ctx context.Context) error {
+	if app.runner == nil {
+		// the default runner is set here instead of in New, because New returns the app by value and the runner
+		// must point to the world of the app itself
+		app.SetFixedRunner()
+	}
+
+	systems, err := app.getSystems(app.runner)
+	if err != nil {
+		return err
+	}
+
+	// stop on either ctx or an exit request
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-app.exit.channel:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	onceRunner := onceRunner{
+		world:       &app.world,
+		outerWorlds: &app.outerWorlds,
+		logger:      app.logger,
+		appName:     app.name,
+	}
+
+	app.context.set(ctx)
+	onceRunner.Run(ctx.Done(), systems.startup)
+
+	var runnerErr error
+	if runner, ok := app.runner.(ContextRunner); ok {
+		if err := runner.RunContext(ctx, systems.repeated); err != nil {
+			runnerErr = fmt.Errorf("runner returned error: %w", err)
+		}
+	} else {
+		app.runner.Run(ctx.Done(), systems.repeated)
+	}
+
+	app.context.set(context.WithoutCancel(ctx))
+	onceRunner.Run(ctx.Done(), systems.cleanup)
+	app.context.set(context.Background())
+
+	return runnerErr
+}
+
+// appSystems holds the system sets of an app in the way that they are run.
+type appSystems struct {
+	startup  []*SystemSet
+	repeated []*SystemSet // includes the state and frame systems, unless runner runs the frame systems
+	cleanup  []*SystemSet
+}
+
+// getSystems returns the ordered system sets of all schedules. If runner is a frameRunner, the frame systems are
+// passed to runner. Otherwise they are added to the repeated systems.
+//
+// Features that were added since the previous call are finished first.
+func (app *SubApp) getSystems(runner Runner) (appSystems, error) {
+	result := appSystems{}
+
+	if err := app.finishFeatures(); err != nil {
+		return result, err
+	}
+
 	startupSystems, err := app.schedules[ScheduleTypeStartup].GetSystemSets()
 	if err != nil {
-		app.logger.Error(fmt.Sprintf("%s - failed to get startup systems: %v", app.name, err))
-		return
+		return result, fmt.Errorf("failed to get startup systems: %w", err)
 	}
 
 	repeatedSystems, err := app.schedules[ScheduleTypeRepeating].GetSystemSets()
 	if err != nil {
-		app.logger.Error(fmt.Sprintf("%s - failed to get repeated systems: %v", app.name, err))
-		return
+		return result, fmt.Errorf("failed to get repeated systems: %w", err)
+	}
+
+	if len(app.states) > 0 {
+		stateSystems, err := app.schedules[scheduleTypeState].GetSystemSetsBySchedule()
+		if err != nil {
+			return result, fmt.Errorf("failed to get state systems: %w", err)
+		}
+
+		stateSystemSet := newStateSystemSet(app.states, stateSystems, &app.world, &app.outerWorlds)
+		repeatedSystems = append([]*SystemSet{stateSystemSet}, repeatedSystems...)
+	}
+
+	repeatedSystems = append([]*SystemSet{newUpdateTimeSystemSet(app.time, app.clock, runner)}, repeatedSystems...)
+
+	frameSystems, err := app.schedules[ScheduleTypeFrame].GetSystemSets()
+	if err != nil {
+		return result, fmt.Errorf("failed to get frame systems: %w", err)
+	}
+
+	// events are updated at the end of frames in which a tick ran, so that events that are sent during any of the
+	// ticks of a frame can still be read by the frame systems, and events are not dropped by frames without a tick
+	// before the repeated systems have read them
+	updateEventsSystems := []*SystemSet{newUpdateEventsSystemSet(&app.resources)}
+
+	if runner, ok := runner.(frameRunner); ok {
+		runner.setFrameSystems(frameSystems, updateEventsSystems)
+	} else {
+		// every run of the repeated systems is a frame
+		repeatedSystems = append(repeatedSystems, frameSystems...)
+		repeatedSystems = append(repeatedSystems, updateEventsSystems...)
 	}
 
 	cleanupSystems, err := app.schedules[ScheduleTypeCleanup].GetSystemSets()
 	if err != nil {
-		app.logger.Error(fmt.Sprintf("%s - failed to get cleanup systems: %v", app.name, err))
+		return result, fmt.Errorf("failed to get cleanup systems: %w", err)
+	}
+
+	result.startup = startupSystems
+	result.repeated = repeatedSystems
+	result.cleanup = cleanupSystems
+	return result, nil
+}
+
+// Startup prepares the app to be stepped manually with Step, and runs the startup schedules on the calling
+// goroutine. This is an alternative to Run that gives full control over when the app updates, which makes
+// it possible to deterministically test an app. Use SetClock to control the time that passes between steps.
+//
+//	app.SetClock(clock)
+//	app.Startup()
+//	clock.Advance(time.Second)
+//	app.Step(1)
+//	app.Shutdown()
+func (app *SubApp) Startup() {
+	if app.stepped != nil {
+		app.logger.Error(fmt.Sprintf("%s - failed to start up: already started", app.name))
 		return
 	}
 
-	onceRunner := onceRunner{
-		world:       &app.world,
-		outerWorlds: &app.outerWorlds,
-		logger:      app.logger,
-		appName:     app.name,
+	systems, err := app.getSystems(nil)
+	if err != nil {
+		app.logger.Error(fmt.Sprintf("%s - failed to start up: %v", app.name, err))
+		return
 	}
 
-	onceRunner.Run(exitChannel, startupSystems)
-	app.runner.Run(exitChannel, repeatedSystems)
-	onceRunner.Run(exitChannel, cleanupSystems)
-	isDoneChannel <- true
+	app.stepped = &steppedApp{systems: systems}
+	runSystemSet(systems.startup, &app.world, &app.outerWorlds, app.logger, app.name)
+}
+
+// Step runs the repeated schedules n times on the calling goroutine. Startup must be called first.
+//
+// The delta of each step is the time that the clock advanced since the previous step, so when using a
+// [VirtualClock], advance it before each step to simulate time passing.
+func (app *SubApp) Step(n uint) {
+	if app.stepped == nil {
+		app.logger.Error(fmt.Sprintf("%s - failed to step: not started up", app.name))
+		return
+	}
+
+	for range n {
+		runSystemSet(app.stepped.systems.repeated, &app.world, &app.outerWorlds, app.logger, app.name)
+	}
+}
+
+// Shutdown runs the cleanup schedules on the calling goroutine. Startup must be called first. Afterwards, the app
+// can not be stepped anymore.
+func (app *SubApp) Shutdown() {
+	if app.stepped == nil {
+		app.logger.Error(fmt.Sprintf("%s - failed to shut down: not started up", app.name))
+		return
+	}
+
+	runSystemSet(app.stepped.systems.cleanup, &app.world, &app.outerWorlds, app.logger, app.name)
+	app.stepped = nil
+}
+
+// SetClock sets the clock that is used to measure the time that passes between ticks, see [Time]. It must be set
+// before the app starts running.
+func (app *SubApp) SetClock(clock Clock) {
+	if clock == nil {
+		app.logger.Error(fmt.Sprintf("%s - failed to set clock: can not be nil", app.name))
+		return
+	}
+
+	app.clock = clock
 }
 
 func (app *SubApp) SetName(name string) {
 	app.name = name
 }
 
+func (app *SubApp) Name() string {
+	return app.name
+}
+
+// Errors returns the errors that were logged by the app, such as errors that were returned by systems and errors of
+// adding systems and resources. Only the first 100 errors are returned.
+func (app *SubApp) Errors() []error {
+	return app.logger.collectedErrors()
+}
+
 // SetTickRate sets the interval at which the repeated systems are run. This can be safely changed while
 // the app is already running, in which case it will be picked up after the next run.
 func (app *SubApp) SetTickRate(tickRate time.Duration) {
@@ -200,12 +513,14 @@ func (app *SubApp) SetTickRate(tickRate time.Duration) {
 	*app.tickRate = tickRate
 }
 
+// Delta returns the delta time of the last tick in seconds. See [Time].
 func (app *SubApp) Delta() float64 {
-	return *app.lastDelta
+	return app.time.DeltaSeconds()
 }
 
+// NumberOfResources returns the number of resources that were added, not counting reserved resources such as [Time].
 func (app *SubApp) NumberOfResources() uint {
-	return uint(len(app.resources.resources))
+	return app.resources.numberOfUserResources()
 }
 
 func (app *SubApp) NumberOfSystems() uint {
@@ -249,10 +564,44 @@ func (app *SubApp) SetRunner(runner Runner) {
 func (app *SubApp) SetFixedRunner() {
 	app.runner = &fixedRunner{
 		tickRate:    app.tickRate,
-		delta:       app.lastDelta,
 		world:       &app.world,
 		outerWorlds: &app.outerWorlds,
 		logger:      app.logger,
 		appName:     app.name,
 	}
 }
+
+// SetFixedTimestepRunner sets a runner that runs the repeated systems once for every tick rate of simulated time,
+// which is kept track of in an accumulator so that no time is lost after slow ticks. It checks how many ticks it has
+// to run once every frameRate, and runs at most maxTicksPerFrame ticks to catch up. After catching up, the frame
+// schedules are run once.
+//
+// The [FixedTimestep] resource is added so that frame schedules can interpolate between the last two ticks.
+func (app *SubApp) SetFixedTimestepRunner(frameRate time.Duration, maxTicksPerFrame uint) {
+	if frameRate == 0 {
+		app.logger.Error(fmt.Sprintf("%s - failed to set fixed timestep runner: frameRate can not be zero", app.name))
+		return
+	}
+	if maxTicksPerFrame == 0 {
+		app.logger.Error(fmt.Sprintf("%s - failed to set fixed timestep runner: maxTicksPerFrame can not be zero", app.name))
+		return
+	}
+
+	timestep, err := getResourceFromStorage[*FixedTimestep](&app.resources)
+	if err != nil {
+		timestep = &FixedTimestep{step: *app.tickRate}
+		app.AddResource(timestep)
+	}
+
+	app.runner = &fixedTimestepRunner{
+		tickRate:         app.tickRate,
+		frameRate:        frameRate,
+		maxTicksPerFrame: maxTicksPerFrame,
+		timestep:         timestep,
+		world:            &app.world,
+		outerWorlds:      &app.outerWorlds,
+		logger:           app.logger,
+		appName:          app.name,
+		clock:            &app.clock,
+	}
+}
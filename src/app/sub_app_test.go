@@ -2,6 +2,7 @@ package app
 
 import (
 	"testing"
+	"time"
 
 	"github.com/lucdrenth/murphecs/src/ecs"
 	"github.com/stretchr/testify/assert"
@@ -89,3 +90,251 @@ func TestSetRunner(t *testing.T) {
 		assert.Equal(uint(0), logger.err)
 	})
 }
+
+func TestFrameSchedule(t *testing.T) {
+	t.Run("runs frame schedules after each run of the repeated schedules if the runner does not run them", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+
+		const frameSchedule Schedule = "frame"
+		order := []string{}
+		app.AddSchedule(testSchedule, ScheduleTypeRepeating)
+		app.AddSchedule(frameSchedule, ScheduleTypeFrame)
+		app.AddSystem(frameSchedule, func() { order = append(order, "frame") })
+		app.AddSystem(testSchedule, func() { order = append(order, "tick") })
+
+		runApp(&app, 2)
+		assert.Equal(uint(0), logger.err)
+		assert.Equal([]string{"tick", "frame", "tick", "frame"}, order)
+	})
+
+	t.Run("logs an error when setting a fixed timestep runner with invalid arguments", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+
+		app.SetFixedTimestepRunner(0, 5)
+		app.SetFixedTimestepRunner(time.Millisecond, 0)
+		assert.Equal(uint(2), logger.err)
+	})
+
+	t.Run("frame schedules read the events of all ticks that ran during the frame", func(t *testing.T) {
+		assert := assert.New(t)
+
+		type testEvent struct{}
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+
+		const frameSchedule Schedule = "frame"
+		app.AddSchedule(testSchedule, ScheduleTypeRepeating)
+		app.AddSchedule(frameSchedule, ScheduleTypeFrame)
+		app.SetTickRate(10 * time.Millisecond)
+		app.SetFixedTimestepRunner(time.Millisecond, 5)
+
+		eventsRead := 0
+		app.AddSystem(testSchedule, func(writer *EventWriter[testEvent]) { writer.Send(testEvent{}) })
+		app.AddSystem(frameSchedule, func(reader *EventReader[testEvent]) {
+			for range reader.Read() {
+				eventsRead++
+			}
+		})
+
+		systems, err := app.getSystems(app.runner)
+		assert.NoError(err)
+		runner := app.runner.(*fixedTimestepRunner)
+
+		runner.frame(35*time.Millisecond, systems.repeated)
+		assert.Equal(3, eventsRead)
+
+		runner.frame(10*time.Millisecond, systems.repeated)
+		assert.Equal(4, eventsRead)
+		assert.Equal(uint(0), logger.err)
+	})
+
+	t.Run("repeated schedules read all events when frames are more frequent than ticks", func(t *testing.T) {
+		assert := assert.New(t)
+
+		type testEvent struct{}
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+
+		app.AddSchedule(testSchedule, ScheduleTypeRepeating)
+		app.SetTickRate(10 * time.Millisecond)
+		app.SetFixedTimestepRunner(time.Millisecond, 5)
+
+		// the reader runs before the writer, so it reads the event of a tick in the next tick
+		eventsRead := 0
+		app.AddSystem(testSchedule, func(reader *EventReader[testEvent]) {
+			for range reader.Read() {
+				eventsRead++
+			}
+		})
+		app.AddSystem(testSchedule, func(writer *EventWriter[testEvent]) { writer.Send(testEvent{}) })
+
+		systems, err := app.getSystems(app.runner)
+		assert.NoError(err)
+		runner := app.runner.(*fixedTimestepRunner)
+
+		for range 50 {
+			runner.frame(time.Millisecond, systems.repeated)
+		}
+		assert.Equal(4, eventsRead)
+		assert.Equal(uint(0), logger.err)
+	})
+}
+
+func TestManualStepping(t *testing.T) {
+	t.Run("runs startup, repeated and cleanup schedules", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+
+		const startupSchedule Schedule = "startup"
+		const cleanupSchedule Schedule = "cleanup"
+		order := []string{}
+		app.AddSchedule(startupSchedule, ScheduleTypeStartup)
+		app.AddSchedule(testSchedule, ScheduleTypeRepeating)
+		app.AddSchedule(cleanupSchedule, ScheduleTypeCleanup)
+		app.AddSystem(startupSchedule, func() { order = append(order, "startup") })
+		app.AddSystem(testSchedule, func() { order = append(order, "update") })
+		app.AddSystem(cleanupSchedule, func() { order = append(order, "cleanup") })
+
+		app.Startup()
+		assert.Equal([]string{"startup"}, order)
+
+		app.Step(2)
+		assert.Equal([]string{"startup", "update", "update"}, order)
+
+		app.Step(1)
+		app.Shutdown()
+		assert.Equal([]string{"startup", "update", "update", "update", "cleanup"}, order)
+		assert.Equal(uint(0), logger.err)
+	})
+
+	t.Run("measures delta with the clock", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+
+		clock := NewVirtualClock(time.Time{})
+		app.SetClock(clock)
+
+		deltas := []float64{}
+		app.AddSchedule(testSchedule, ScheduleTypeRepeating)
+		app.AddSystem(testSchedule, func() { deltas = append(deltas, app.Delta()) })
+
+		app.Startup()
+		clock.Advance(time.Second)
+		app.Step(1)
+		clock.Advance(250 * time.Millisecond)
+		app.Step(2)
+
+		assert.Equal([]float64{1, 0.25, 0}, deltas)
+		assert.Equal(uint(0), logger.err)
+	})
+
+	t.Run("logs an error when stepping or shutting down before starting up", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+
+		app.Step(1)
+		app.Shutdown()
+		assert.Equal(uint(2), logger.err)
+	})
+
+	t.Run("logs an error when starting up twice", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+
+		app.Startup()
+		app.Startup()
+		assert.Equal(uint(1), logger.err)
+	})
+
+	t.Run("logs an error when setting a nil clock", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+
+		app.SetClock(nil)
+		assert.Equal(uint(1), logger.err)
+	})
+}
+
+func TestOuterWorldQueriesBetweenRunningApps(t *testing.T) {
+	type componentA struct {
+		ecs.Component
+		value int
+	}
+
+	assert := assert.New(t)
+
+	logger := testLogger{}
+	worldConfigs := ecs.DefaultWorldConfigs()
+	worldConfigs.Id = &ecs.TestCustomTargetWorldId
+	appFoo, err := New(&logger, worldConfigs)
+	assert.NoError(err)
+	appFoo.SetTickRate(time.Millisecond)
+	appFoo.AddSchedule(testSchedule, ScheduleTypeRepeating)
+
+	appBar, err := New(&logger, ecs.DefaultWorldConfigs())
+	assert.NoError(err)
+	appBar.SetTickRate(time.Millisecond)
+	appBar.AddSchedule(testSchedule, ScheduleTypeRepeating)
+	assert.NoError(appBar.RegisterOuterWorld(ecs.TestCustomTargetWorldId, appFoo.World()))
+
+	// appFoo changes its world on every tick while appBar queries it
+	appFoo.AddSystem(testSchedule, func(world *ecs.World, query *ecs.Query1[componentA, ecs.Default]) error {
+		query.Result().Range()(func(a *componentA) bool {
+			a.value++
+			return true
+		})
+
+		_, err := ecs.Spawn(world, &componentA{})
+		return err
+	})
+
+	numberOfResults := uint(0)
+	appBar.AddSystem(testSchedule, func(query *ecs.Query1[componentA, ecs.QueryOptions2[ecs.TestCustomTargetWorld, ecs.AllReadOnly]]) {
+		numberOfResults = query.Result().NumberOfResult()
+		query.Result().Range()(func(a *componentA) bool {
+			_ = a.value
+			return true
+		})
+	})
+
+	exitChannel := make(chan struct{})
+	isDoneFoo := make(chan bool, 1)
+	isDoneBar := make(chan bool, 1)
+	go appFoo.Run(exitChannel, isDoneFoo)
+	go appBar.Run(exitChannel, isDoneBar)
+
+	time.Sleep(100 * time.Millisecond)
+	close(exitChannel)
+	<-isDoneFoo
+	<-isDoneBar
+
+	assert.Equal(uint(0), logger.err)
+	assert.NotZero(numberOfResults)
+}
@@ -1,18 +1,110 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"reflect"
+	"runtime"
 	"strings"
+	"sync"
 
 	"github.com/lucdrenth/murphecs/src/ecs"
 )
 
 type System any
 
+// RunCondition is a function that returns a bool and that can have the same params as a System.
+type RunCondition any
+
+// systemParam is implemented by system params that are initialized by the app when a system is added, such
+// as EventReader and EventWriter. They must be used as a pointer.
+type systemParam interface {
+	init(resources *resourceStorage) error
+}
+
 type systemEntry struct {
-	system reflect.Value
-	params []reflect.Value
+	system               reflect.Value
+	params               []reflect.Value
+	queries              []ecs.Query
+	queriesToOuterWorlds []queryToOuterWorld
+	access               systemAccess
+	stage                int // index in to SystemSet.stages
+	name                 string
+	labels               []SystemLabel
+	before               []SystemLabel
+	after                []SystemLabel
+	conditions           []systemEntry // run conditions, the system only runs if all of them return true
+	shouldRunCurrentTick bool
+	resources            *resourceStorage
+	resourceParams       []int          // indices in to params of the params that hold on to a resource
+	resourcesVersion     uint           // version of resources at the moment that the resource params were bound
+	hasResources         bool           // wether all resources of the resource params were present when they were bound
+	missingResources     []reflect.Type // resources of the resource params that were not present when they were bound
+	isMissingLogged      bool           // wether it is logged that the system is skipped because of missingResources
+	logger               Logger
+}
+
+// bindResources binds the resource params again if resources were inserted, replaced or removed since they were
+// last bound. Returns wether all resources that the params use are present. If they are not, a warning is logged
+// once until they are present again.
+func (s *systemEntry) bindResources() bool {
+	if s.resources == nil || len(s.resourceParams) == 0 {
+		return true
+	}
+
+	if s.resourcesVersion != s.resources.version {
+		s.rebindResources()
+	}
+
+	if s.hasResources {
+		s.isMissingLogged = false
+	} else if !s.isMissingLogged && s.logger != nil {
+		s.isMissingLogged = true
+		s.logger.Warn(fmt.Sprintf("system %s does not run because resource(s) %s are not present", s.name, resourceTypesToString(s.missingResources)))
+	}
+
+	return s.hasResources
+}
+
+// rebindResources binds all resource params to the current resources. Params of which the resource is not present
+// stay unbound until it is inserted, their resources are kept in missingResources.
+func (s *systemEntry) rebindResources() {
+	s.resourcesVersion = s.resources.version
+	s.hasResources = true
+	s.missingResources = nil
+
+	for _, i := range s.resourceParams {
+		if param, ok := s.params[i].Interface().(boundResourceParam); ok {
+			if err := param.bind(s.resources); err != nil {
+				s.hasResources = false
+				s.missingResources = append(s.missingResources, param.resourceType())
+			}
+			continue
+		}
+
+		parameterType := s.system.Type().In(i)
+		resource, err := s.resources.getReflectResource(parameterType)
+		if err != nil {
+			s.hasResources = false
+			s.missingResources = append(s.missingResources, parameterType)
+			continue
+		}
+
+		if parameterType.Kind() == reflect.Pointer {
+			s.params[i] = resource
+		} else {
+			s.params[i] = resource.Elem()
+		}
+	}
+}
+
+func resourceTypesToString(resourceTypes []reflect.Type) string {
+	result := make([]string, len(resourceTypes))
+	for i, resourceType := range resourceTypes {
+		result[i] = resourceType.String()
+	}
+
+	return strings.Join(result, ", ")
 }
 
 func (s *systemEntry) exec() error {
@@ -28,10 +120,22 @@ func (s *systemEntry) exec() error {
 	return nil
 }
 
+// SystemSet holds the systems of a schedule.
+//
+// Systems run in parallel when they do not access the same data through their params. Two systems are run one
+// after the other, in the order that they were added, if:
+//   - they both use a query that fetches the same component and at least one of them can mutate it.
+//   - they both use the same resource and at least one of them uses it by reference or with ResMut.
+//   - one of them uses *ecs.World and the other one uses anything of that world.
+//
+// Data that is shared between systems by any other means, such as variables that are captured by a closure, must
+// be synchronized by the systems themselves.
 type SystemSet struct {
-	systems                         []systemEntry
-	systemParamQueries              []ecs.Query
-	systemParamQueriesToOuterWorlds []queryToOuterWorld
+	systems    []systemEntry
+	stages     [][]int       // indices of systems that can run in parallel, in order of execution
+	conditions []systemEntry // run conditions, the systems only run if all of them return true
+	commands   []*Commands
+	nested     []*SystemSet // system sets that are executed by the systems of this set
 }
 
 type queryToOuterWorld struct {
@@ -39,19 +143,115 @@ type queryToOuterWorld struct {
 	query   ecs.Query
 }
 
+// Exec runs the systems of the set. Run conditions are checked before any of the systems run, so they see the
+// state of the world and resources from before the set was executed. Systems that use a resource that is not
+// present, because it was removed with [CommandsRemoveResource], do not run. A warning is logged once when a system
+// starts being skipped.
+//
+// While the set is executed, world is locked for writing. The outer worlds that are queried by the set are locked
+// for writing if a query can mutate their components, and for reading otherwise. See [ecs.LockWorlds].
 func (s *SystemSet) Exec(world *ecs.World, outerWorlds *map[ecs.WorldId]*ecs.World) []error {
-	err := s.handleSystemParamQueries(world, outerWorlds)
+	writeWorlds, readWorlds := s.queriedOuterWorlds(outerWorlds)
+	unlock := ecs.LockWorlds(append(writeWorlds, world), readWorlds)
+	defer unlock()
+
+	return s.exec(world, outerWorlds)
+}
+
+// exec runs the systems of the set without locking any worlds.
+func (s *SystemSet) exec(world *ecs.World, outerWorlds *map[ecs.WorldId]*ecs.World) []error {
+	shouldRun, err := checkRunConditions(s.conditions, world, outerWorlds)
+	if err != nil {
+		return []error{
+			fmt.Errorf("did not execute system set because run condition failed: %w", err),
+		}
+	}
+	if !shouldRun {
+		return []error{}
+	}
+
+	errors := []error{}
+	for i := range s.systems {
+		system := &s.systems[i]
+
+		// systems of which a resource was removed do not run until it is inserted again
+		if !system.bindResources() {
+			system.shouldRunCurrentTick = false
+			continue
+		}
+
+		system.shouldRunCurrentTick, err = checkRunConditions(system.conditions, world, outerWorlds)
+		if err != nil {
+			system.shouldRunCurrentTick = false
+			errors = append(errors, fmt.Errorf("did not execute system %s because run condition failed: %w", system.name, err))
+		}
+	}
+
+	// queries of systems that do not run are not executed, so that they do not miss any changes
+	err = s.handleSystemParamQueries(world, outerWorlds)
 	if err != nil {
 		return []error{
 			fmt.Errorf("did not execute system set because query failed: %w", err),
 		}
 	}
 
-	return s.execSystems()
+	errors = append(errors, s.execSystems()...)
+	return append(errors, s.applyCommands(world)...)
+}
+
+// queriedOuterWorlds returns the outer worlds that are queried by the systems and run conditions of this set and
+// its nested sets. Worlds that are queried for components that can be mutated are returned as write worlds, because
+// executing such a query marks the components as changed.
+func (s *SystemSet) queriedOuterWorlds(outerWorlds *map[ecs.WorldId]*ecs.World) (writeWorlds []*ecs.World, readWorlds []*ecs.World) {
+	if outerWorlds == nil {
+		return nil, nil
+	}
+
+	addQueriedOuterWorlds := func(entry *systemEntry) {
+		for _, query := range entry.queriesToOuterWorlds {
+			world := (*outerWorlds)[query.worldId]
+			if _, mutable := query.query.ComponentAccess(); len(mutable) > 0 {
+				writeWorlds = append(writeWorlds, world)
+			} else {
+				readWorlds = append(readWorlds, world)
+			}
+		}
+	}
+
+	for i := range s.conditions {
+		addQueriedOuterWorlds(&s.conditions[i])
+	}
+	for i := range s.systems {
+		addQueriedOuterWorlds(&s.systems[i])
+		for j := range s.systems[i].conditions {
+			addQueriedOuterWorlds(&s.systems[i].conditions[j])
+		}
+	}
+	for _, nested := range s.nested {
+		nestedWriteWorlds, nestedReadWorlds := nested.queriedOuterWorlds(outerWorlds)
+		writeWorlds = append(writeWorlds, nestedWriteWorlds...)
+		readWorlds = append(readWorlds, nestedReadWorlds...)
+	}
+
+	return writeWorlds, readWorlds
 }
 
 func (s *SystemSet) handleSystemParamQueries(world *ecs.World, outerWorlds *map[ecs.WorldId]*ecs.World) error {
-	for _, query := range s.systemParamQueries {
+	for i := range s.systems {
+		if !s.systems[i].shouldRunCurrentTick {
+			continue
+		}
+
+		if err := s.systems[i].execQueries(world, outerWorlds); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *systemEntry) execQueries(world *ecs.World, outerWorlds *map[ecs.WorldId]*ecs.World) error {
+	for _, query := range s.queries {
 		if query.IsLazy() {
 			query.ClearResults()
 		} else {
@@ -62,7 +262,7 @@ func (s *SystemSet) handleSystemParamQueries(world *ecs.World, outerWorlds *map[
 		}
 	}
 
-	for _, outerWorldQuery := range s.systemParamQueriesToOuterWorlds {
+	for _, outerWorldQuery := range s.queriesToOuterWorlds {
 		err := outerWorldQuery.query.Exec((*outerWorlds)[outerWorldQuery.worldId])
 		if err != nil {
 			return err
@@ -72,11 +272,54 @@ func (s *SystemSet) handleSystemParamQueries(world *ecs.World, outerWorlds *map[
 	return nil
 }
 
+// checkRunConditions returns wether all conditions return true. The queries of each condition are executed
+// right before the condition is checked.
+func checkRunConditions(conditions []systemEntry, world *ecs.World, outerWorlds *map[ecs.WorldId]*ecs.World) (bool, error) {
+	for i := range conditions {
+		if !conditions[i].bindResources() {
+			return false, nil
+		}
+
+		if err := conditions[i].execQueries(world, outerWorlds); err != nil {
+			return false, err
+		}
+
+		if !conditions[i].system.Call(conditions[i].params)[0].Bool() {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
 func (s *SystemSet) execSystems() []error {
-	errors := []error{}
+	systemErrors := make([]error, len(s.systems))
 
-	for i := range s.systems {
-		err := s.systems[i].exec()
+	for _, stage := range s.stages {
+		if len(stage) == 1 {
+			if s.systems[stage[0]].shouldRunCurrentTick {
+				systemErrors[stage[0]] = s.systems[stage[0]].exec()
+			}
+			continue
+		}
+
+		var waitGroup sync.WaitGroup
+		for _, i := range stage {
+			if !s.systems[i].shouldRunCurrentTick {
+				continue
+			}
+
+			waitGroup.Add(1)
+			go func() {
+				defer waitGroup.Done()
+				systemErrors[i] = s.systems[i].exec()
+			}()
+		}
+		waitGroup.Wait()
+	}
+
+	errors := []error{}
+	for _, err := range systemErrors {
 		if err != nil {
 			errors = append(errors, err)
 		}
@@ -85,67 +328,178 @@ func (s *SystemSet) execSystems() []error {
 	return errors
 }
 
+// addEntry adds entry to the first stage, starting from minStage, after the stages of all systems that it
+// conflicts with, so that conflicting systems keep running in the order that they were added.
+func (s *SystemSet) addEntry(entry systemEntry, minStage int) {
+	entry.stage = minStage
+	for i := range s.systems {
+		if entry.access.conflictsWith(&s.systems[i].access) {
+			entry.stage = max(entry.stage, s.systems[i].stage+1)
+		}
+	}
+
+	for entry.stage >= len(s.stages) {
+		s.stages = append(s.stages, []int{})
+	}
+
+	s.stages[entry.stage] = append(s.stages[entry.stage], len(s.systems))
+	s.systems = append(s.systems, entry)
+}
+
 func (s *SystemSet) add(sys System, world *ecs.World, outerWorlds *map[ecs.WorldId]*ecs.World, logger Logger, resources *resourceStorage) error {
+	sys, config := unwrapSystem(sys)
 	systemValue := reflect.ValueOf(sys)
-	queryType := reflect.TypeOf((*ecs.Query)(nil)).Elem()
 
 	if err := validateSystem(systemValue); err != nil {
 		return fmt.Errorf("system is not valid: %w", err)
 	}
 
-	numberOfParams := systemValue.Type().NumIn()
-	params := make([]reflect.Value, numberOfParams)
+	entry, err := s.parseSystemParams(systemValue, world, outerWorlds, logger, resources)
+	if err != nil {
+		return err
+	}
+
+	for i, condition := range config.conditions {
+		conditionEntry, err := s.parseRunCondition(condition, world, outerWorlds, logger, resources)
+		if err != nil {
+			return fmt.Errorf("run condition %d: %w", i+1, err)
+		}
+
+		entry.conditions = append(entry.conditions, conditionEntry)
+	}
+
+	entry.labels = config.labels
+	entry.before = config.before
+	entry.after = config.after
+	s.addEntry(entry, 0)
+	return nil
+}
+
+// addRunCondition adds a condition that must return true for any of the systems of this set to run.
+func (s *SystemSet) addRunCondition(condition RunCondition, world *ecs.World, outerWorlds *map[ecs.WorldId]*ecs.World, logger Logger, resources *resourceStorage) error {
+	conditionEntry, err := s.parseRunCondition(condition, world, outerWorlds, logger, resources)
+	if err != nil {
+		return err
+	}
+
+	s.conditions = append(s.conditions, conditionEntry)
+	return nil
+}
+
+func (s *SystemSet) parseRunCondition(condition RunCondition, world *ecs.World, outerWorlds *map[ecs.WorldId]*ecs.World, logger Logger, resources *resourceStorage) (systemEntry, error) {
+	conditionValue := reflect.ValueOf(condition)
+
+	if err := validateRunCondition(conditionValue); err != nil {
+		return systemEntry{}, fmt.Errorf("%w: %w", ErrRunConditionNotValid, err)
+	}
+
+	return s.parseSystemParams(conditionValue, world, outerWorlds, logger, resources)
+}
+
+// parseSystemParams returns a systemEntry for sys, of which the params are resolved.
+func (s *SystemSet) parseSystemParams(sys reflect.Value, world *ecs.World, outerWorlds *map[ecs.WorldId]*ecs.World, logger Logger, resources *resourceStorage) (systemEntry, error) {
+	queryType := reflect.TypeOf((*ecs.Query)(nil)).Elem()
+	systemParamType := reflect.TypeFor[systemParam]()
+
+	numberOfParams := sys.Type().NumIn()
+	entry := systemEntry{
+		system:       sys,
+		params:       make([]reflect.Value, numberOfParams),
+		name:         systemName(sys),
+		resources:    resources,
+		hasResources: true,
+		logger:       logger,
+	}
 
 	for i := range numberOfParams {
-		parameterType := systemValue.Type().In(i)
+		parameterType := sys.Type().In(i)
 
 		if parameterType.Implements(queryType) {
 			query, err := parseQueryParam(parameterType, world, logger, outerWorlds)
 			if err != nil {
-				return fmt.Errorf("%w: %w", ErrSystemParamQueryNotValid, err)
+				return entry, fmt.Errorf("%w: %w", ErrSystemParamQueryNotValid, err)
 			}
 
 			if query.TargetWorld() != nil {
-				s.systemParamQueriesToOuterWorlds = append(s.systemParamQueriesToOuterWorlds, queryToOuterWorld{
+				entry.queriesToOuterWorlds = append(entry.queriesToOuterWorlds, queryToOuterWorld{
 					worldId: *query.TargetWorld(),
 					query:   query,
 				})
+				entry.access.addQuery(query, (*outerWorlds)[*query.TargetWorld()])
 			} else {
-				s.systemParamQueries = append(s.systemParamQueries, query)
+				entry.queries = append(entry.queries, query)
+				entry.access.addQuery(query, world)
 			}
 
-			params[i] = reflect.ValueOf(query)
+			entry.params[i] = reflect.ValueOf(query)
 		} else if parameterType == reflect.TypeFor[*ecs.World]() {
-			params[i] = reflect.ValueOf(world)
+			entry.params[i] = reflect.ValueOf(world)
+			entry.access.world = world
 		} else if parameterType == reflect.TypeFor[ecs.World]() {
 			// ecs.World may not be used by-value because:
 			//	1. it is a potentially big object and copying it could give bad performance
 			//	2. it is probably unintended and would cause unexpected behavior
-			return fmt.Errorf("system parameter %d: %w", i+1, ErrSystemParamWorldNotAPointer)
-		} else { // assume its a resource
-			resource, err := resources.getReflectResource(parameterType)
+			return entry, fmt.Errorf("system parameter %d: %w", i+1, ErrSystemParamWorldNotAPointer)
+		} else if parameterType == reflect.TypeFor[context.Context]() {
+			ctx, err := getResourceFromStorage[*runContext](resources)
 			if err != nil {
-				if parameterType.Kind() != reflect.Pointer && reflect.PointerTo(parameterType).Implements(reflect.TypeFor[ecs.Query]()) {
-					return fmt.Errorf("system parameter %d: %w", i+1, ErrSystemParamQueryNotAPointer)
-				}
+				// the system set is not part of an app, so there is no context of a run
+				entry.params[i] = reflect.ValueOf(context.Background())
+			} else {
+				entry.params[i] = reflect.ValueOf(ctx)
+			}
+		} else if parameterType.Implements(systemParamType) {
+			param, err := parseSystemParam(parameterType, resources)
+			if err != nil {
+				return entry, fmt.Errorf("system parameter %d: %w: %w", i+1, ErrSystemParamNotValid, err)
+			}
 
-				return fmt.Errorf("system parameter %d: %w", i+1, ErrSystemParamNotValid)
+			if commands, ok := param.(*Commands); ok {
+				s.commands = append(s.commands, commands)
+			}
+			if paramWithAccess, ok := param.(systemParamWithAccess); ok {
+				entry.access.resources = append(entry.access.resources, paramWithAccess.access()...)
+			}
+			if _, ok := param.(boundResourceParam); ok {
+				entry.resourceParams = append(entry.resourceParams, i)
 			}
 
-			if parameterType.Kind() == reflect.Pointer {
-				params[i] = resource
-			} else {
-				params[i] = resource.Elem()
+			entry.params[i] = reflect.ValueOf(param)
+		} else { // assume its a resource
+			if parameterType.Kind() != reflect.Pointer && reflect.PointerTo(parameterType).Implements(reflect.TypeFor[ecs.Query]()) {
+				return entry, fmt.Errorf("system parameter %d: %w", i+1, ErrSystemParamQueryNotAPointer)
 			}
+
+			if parameterType.Kind() != reflect.Pointer && reflect.PointerTo(parameterType).Implements(systemParamType) {
+				return entry, fmt.Errorf("system parameter %d: %w", i+1, ErrSystemParamNotAPointer)
+			}
+
+			if resources == nil {
+				return entry, fmt.Errorf("system parameter %d: %w", i+1, ErrSystemParamNotValid)
+			}
+
+			// the param is bound below, or once the resource gets inserted if a command that inserts it is pending
+			entry.params[i] = reflect.Zero(parameterType)
+			entry.resourceParams = append(entry.resourceParams, i)
+
+			entry.access.resources = append(entry.access.resources, resourceAccess{
+				resourceId: reflectTypeToComponentId(parameterType),
+				mutable:    parameterType.Kind() == reflect.Pointer,
+			})
 		}
 	}
 
-	entry := systemEntry{
-		system: systemValue,
-		params: params,
+	if resources != nil && len(entry.resourceParams) > 0 {
+		entry.rebindResources()
+
+		for _, resourceType := range entry.missingResources {
+			if !resources.isPending(resourceType) {
+				return entry, fmt.Errorf("%w: resource %s: %w", ErrSystemParamNotValid, resourceType.String(), ErrResourceNotFound)
+			}
+		}
 	}
-	s.systems = append(s.systems, entry)
-	return nil
+
+	return entry, nil
 }
 
 func parseQueryParam(parameterType reflect.Type, world *ecs.World, logger Logger, outerWorlds *map[ecs.WorldId]*ecs.World) (ecs.Query, error) {
@@ -186,6 +540,23 @@ func parseQueryParam(parameterType reflect.Type, world *ecs.World, logger Logger
 	return query, nil
 }
 
+func parseSystemParam(parameterType reflect.Type, resources *resourceStorage) (systemParam, error) {
+	if parameterType.Kind() == reflect.Interface {
+		return nil, fmt.Errorf("can not be an interface")
+	}
+
+	param, ok := reflect.New(parameterType.Elem()).Interface().(systemParam)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast param to system param")
+	}
+
+	if err := param.init(resources); err != nil {
+		return nil, fmt.Errorf("failed to initialize system param: %w", err)
+	}
+
+	return param, nil
+}
+
 func validateSystem(sys reflect.Value) error {
 	if sys.Kind() != reflect.Func {
 		return ErrSystemNotAFunction
@@ -198,6 +569,18 @@ func validateSystem(sys reflect.Value) error {
 	return nil
 }
 
+func validateRunCondition(condition reflect.Value) error {
+	if condition.Kind() != reflect.Func {
+		return ErrSystemNotAFunction
+	}
+
+	if condition.Type().NumOut() != 1 || condition.Type().Out(0) != reflect.TypeFor[bool]() {
+		return fmt.Errorf("%w: must return a single bool", ErrSystemInvalidReturnType)
+	}
+
+	return nil
+}
+
 func validateSystemReturnTypes(systemValue reflect.Value) error {
 	numberOfSystemReturnValues := systemValue.Type().NumOut()
 
@@ -220,7 +603,18 @@ func validateSystemReturnTypes(systemValue reflect.Value) error {
 
 // systemToDebugString returns a reflection string of the system but with shortened paths.
 func systemToDebugString(system System) string {
+	system, _ = unwrapSystem(system)
 	result := reflect.TypeOf(system).String()
 	result = strings.ReplaceAll(result, "github.com/lucdrenth/murphecs/src/", "murphecs/")
 	return result
 }
+
+// systemName returns the name of the function of a valid system, with shortened paths.
+func systemName(system reflect.Value) string {
+	function := runtime.FuncForPC(system.Pointer())
+	if function == nil {
+		return system.Type().String()
+	}
+
+	return strings.ReplaceAll(function.Name(), "github.com/lucdrenth/murphecs/src/", "murphecs/")
+}
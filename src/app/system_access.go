@@ -0,0 +1,83 @@
+package app
+
+import (
+	"slices"
+
+	"github.com/lucdrenth/murphecs/src/ecs"
+)
+
+// systemAccess describes the data that a system accesses through its params. It is used to determine which
+// systems can safely run in parallel.
+type systemAccess struct {
+	world      *ecs.World // set if the system uses *ecs.World, which gives it access to everything in that world
+	components []componentAccess
+	resources  []resourceAccess
+}
+
+type componentAccess struct {
+	world       *ecs.World
+	componentId ecs.ComponentId
+	mutable     bool
+}
+
+type resourceAccess struct {
+	resourceId resourceId
+	mutable    bool
+}
+
+// systemParamWithAccess is implemented by system params that access resources, so that systems that use them
+// do not run in parallel with systems that conflict with that access.
+type systemParamWithAccess interface {
+	access() []resourceAccess
+}
+
+func (a *systemAccess) addQuery(query ecs.Query, world *ecs.World) {
+	readOnly, mutable := query.ComponentAccess()
+
+	for _, componentId := range readOnly {
+		a.components = append(a.components, componentAccess{world: world, componentId: componentId})
+	}
+	for _, componentId := range mutable {
+		a.components = append(a.components, componentAccess{world: world, componentId: componentId, mutable: true})
+	}
+}
+
+// conflictsWith returns wether running the systems of a and other at the same time could result in a data race.
+func (a *systemAccess) conflictsWith(other *systemAccess) bool {
+	if a.world != nil && other.usesWorld(a.world) {
+		return true
+	}
+
+	if other.world != nil && a.usesWorld(other.world) {
+		return true
+	}
+
+	for _, componentA := range a.components {
+		for _, componentB := range other.components {
+			if componentA.world == componentB.world && componentA.componentId == componentB.componentId && (componentA.mutable || componentB.mutable) {
+				return true
+			}
+		}
+	}
+
+	for _, resourceA := range a.resources {
+		for _, resourceB := range other.resources {
+			if resourceA.resourceId == resourceB.resourceId && (resourceA.mutable || resourceB.mutable) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// usesWorld returns wether anything in world is accessed.
+func (a *systemAccess) usesWorld(world *ecs.World) bool {
+	if a.world == world {
+		return true
+	}
+
+	return slices.ContainsFunc(a.components, func(component componentAccess) bool {
+		return component.world == world
+	})
+}
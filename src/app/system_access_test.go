@@ -0,0 +1,226 @@
+package app
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/lucdrenth/murphecs/src/ecs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSystemStages(t *testing.T) {
+	type componentA struct{ ecs.Component }
+	type componentB struct{ ecs.Component }
+	type resourceA struct{}
+	type resourceB struct{}
+
+	testCases := []struct {
+		description    string
+		systemA        System
+		systemB        System
+		expectConflict bool
+	}{
+		{
+			description:    "systems without params do not conflict",
+			systemA:        func() {},
+			systemB:        func() {},
+			expectConflict: false,
+		},
+		{
+			description:    "queries that mutate different components do not conflict",
+			systemA:        func(_ *ecs.Query1[componentA, ecs.Default]) {},
+			systemB:        func(_ *ecs.Query1[componentB, ecs.Default]) {},
+			expectConflict: false,
+		},
+		{
+			description:    "queries that mutate the same component conflict",
+			systemA:        func(_ *ecs.Query1[componentA, ecs.Default]) {},
+			systemB:        func(_ *ecs.Query2[componentA, componentB, ecs.ReadOnly1[componentB]]) {},
+			expectConflict: true,
+		},
+		{
+			description:    "query that reads a component conflicts with query that mutates it",
+			systemA:        func(_ *ecs.Query1[componentA, ecs.AllReadOnly]) {},
+			systemB:        func(_ *ecs.Query1[componentA, ecs.Default]) {},
+			expectConflict: true,
+		},
+		{
+			description:    "queries that only read the same component do not conflict",
+			systemA:        func(_ *ecs.Query1[componentA, ecs.AllReadOnly]) {},
+			systemB:        func(_ *ecs.Query2[componentA, componentB, ecs.ReadOnly1[componentA]]) {},
+			expectConflict: false,
+		},
+		{
+			description:    "world conflicts with query",
+			systemA:        func(_ *ecs.World) {},
+			systemB:        func(_ *ecs.Query1[componentA, ecs.AllReadOnly]) {},
+			expectConflict: true,
+		},
+		{
+			description:    "world conflicts with world",
+			systemA:        func(_ *ecs.World) {},
+			systemB:        func(_ *ecs.World) {},
+			expectConflict: true,
+		},
+		{
+			description:    "world does not conflict with resource",
+			systemA:        func(_ *ecs.World) {},
+			systemB:        func(_ *resourceA) {},
+			expectConflict: false,
+		},
+		{
+			description:    "by-reference resources of different types do not conflict",
+			systemA:        func(_ *resourceA) {},
+			systemB:        func(_ *resourceB) {},
+			expectConflict: false,
+		},
+		{
+			description:    "by-reference resource conflicts with by-reference resource",
+			systemA:        func(_ *resourceA) {},
+			systemB:        func(_ *resourceA) {},
+			expectConflict: true,
+		},
+		{
+			description:    "by-reference resource conflicts with by-value resource",
+			systemA:        func(_ resourceA) {},
+			systemB:        func(_ *resourceA) {},
+			expectConflict: true,
+		},
+		{
+			description:    "by-value resources do not conflict",
+			systemA:        func(_ resourceA) {},
+			systemB:        func(_ resourceA) {},
+			expectConflict: false,
+		},
+		{
+			description:    "Res resources do not conflict",
+			systemA:        func(_ *Res[resourceA]) {},
+			systemB:        func(_ *Res[resourceA]) {},
+			expectConflict: false,
+		},
+		{
+			description:    "Res resource does not conflict with by-value resource",
+			systemA:        func(_ *Res[resourceA]) {},
+			systemB:        func(_ resourceA) {},
+			expectConflict: false,
+		},
+		{
+			description:    "Res resource conflicts with ResMut resource",
+			systemA:        func(_ *Res[resourceA]) {},
+			systemB:        func(_ *ResMut[resourceA]) {},
+			expectConflict: true,
+		},
+		{
+			description:    "ResMut resource conflicts with by-value resource",
+			systemA:        func(_ *ResMut[resourceA]) {},
+			systemB:        func(_ resourceA) {},
+			expectConflict: true,
+		},
+		{
+			description:    "ResMut resources of different types do not conflict",
+			systemA:        func(_ *ResMut[resourceA]) {},
+			systemB:        func(_ *ResMut[resourceB]) {},
+			expectConflict: false,
+		},
+		{
+			description:    "event writer conflicts with event reader",
+			systemA:        func(_ *EventWriter[testEvent]) {},
+			systemB:        func(_ *EventReader[testEvent]) {},
+			expectConflict: true,
+		},
+		{
+			description:    "event readers do not conflict",
+			systemA:        func(_ *EventReader[testEvent]) {},
+			systemB:        func(_ *EventReader[testEvent]) {},
+			expectConflict: false,
+		},
+		{
+			description:    "commands do not conflict",
+			systemA:        func(_ *Commands) {},
+			systemB:        func(_ *Commands) {},
+			expectConflict: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			systemSet := SystemSet{}
+			world := ecs.NewDefaultWorld()
+			logger := NoOpLogger{}
+			resourceStorage := newResourceStorage()
+			assert.NoError(resourceStorage.add(&resourceA{}))
+			assert.NoError(resourceStorage.add(&resourceB{}))
+
+			err := systemSet.add(tc.systemA, &world, nil, &logger, &resourceStorage)
+			assert.NoError(err)
+			err = systemSet.add(tc.systemB, &world, nil, &logger, &resourceStorage)
+			assert.NoError(err)
+
+			if tc.expectConflict {
+				assert.Equal([][]int{{0}, {1}}, systemSet.stages)
+			} else {
+				assert.Equal([][]int{{0, 1}}, systemSet.stages)
+			}
+		})
+	}
+
+	t.Run("system is added to the stage after the last system it conflicts with", func(t *testing.T) {
+		assert := assert.New(t)
+
+		systemSet := SystemSet{}
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+		assert.NoError(resourceStorage.add(&resourceA{}))
+		assert.NoError(resourceStorage.add(&resourceB{}))
+
+		assert.NoError(systemSet.add(func(_ *resourceA) {}, &world, nil, &logger, &resourceStorage))
+		assert.NoError(systemSet.add(func(_ *resourceA, _ *resourceB) {}, &world, nil, &logger, &resourceStorage))
+		assert.NoError(systemSet.add(func(_ *resourceB) {}, &world, nil, &logger, &resourceStorage))
+		assert.NoError(systemSet.add(func(_ *ecs.World) {}, &world, nil, &logger, &resourceStorage))
+
+		assert.Equal([][]int{{0, 3}, {1}, {2}}, systemSet.stages)
+	})
+}
+
+func TestExecSystemsInParallel(t *testing.T) {
+	t.Run("systems in the same stage run at the same time", func(t *testing.T) {
+		assert := assert.New(t)
+
+		systemSet := SystemSet{}
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+
+		// each system waits for the other one, which would deadlock if they were run one after the other
+		var started sync.WaitGroup
+		started.Add(2)
+		system := func() {
+			started.Done()
+			started.Wait()
+		}
+		assert.NoError(systemSet.add(system, &world, nil, &logger, &resourceStorage))
+		assert.NoError(systemSet.add(system, &world, nil, &logger, &resourceStorage))
+
+		assert.Empty(systemSet.Exec(&world, nil))
+	})
+
+	t.Run("returns errors of all systems in the order that the systems were added", func(t *testing.T) {
+		assert := assert.New(t)
+
+		systemSet := SystemSet{}
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+
+		errA := ErrSystemParamNotValid
+		errB := ErrSystemNotAFunction
+		assert.NoError(systemSet.add(func() error { return errA }, &world, nil, &logger, &resourceStorage))
+		assert.NoError(systemSet.add(func() error { return nil }, &world, nil, &logger, &resourceStorage))
+		assert.NoError(systemSet.add(func() error { return errB }, &world, nil, &logger, &resourceStorage))
+
+		assert.Equal([]error{errA, errB}, systemSet.Exec(&world, nil))
+	})
+}
@@ -0,0 +1,208 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/lucdrenth/murphecs/src/ecs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunConditions(t *testing.T) {
+	type gameState struct {
+		paused bool
+	}
+
+	t.Run("system only runs when its condition returns true", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+		state := &gameState{}
+		err := resourceStorage.add(state)
+		assert.NoError(err)
+
+		counter := 0
+		systemSet := SystemSet{}
+		err = systemSet.add(
+			Configure(func() { counter++ }).RunIf(func(state gameState) bool { return !state.paused }),
+			&world, nil, &logger, &resourceStorage,
+		)
+		assert.NoError(err)
+
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Equal(1, counter)
+
+		state.paused = true
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Equal(1, counter)
+
+		state.paused = false
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Equal(2, counter)
+	})
+
+	t.Run("system only runs when all of its conditions return true", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+
+		first, second := true, false
+		counter := 0
+		systemSet := SystemSet{}
+		err := systemSet.add(
+			Configure(func() { counter++ }).
+				RunIf(func() bool { return first }).
+				RunIf(func() bool { return second }),
+			&world, nil, &logger, &resourceStorage,
+		)
+		assert.NoError(err)
+
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Equal(0, counter)
+
+		second = true
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Equal(1, counter)
+	})
+
+	t.Run("other systems still run when a condition returns false", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+
+		skipped, ran := 0, 0
+		systemSet := SystemSet{}
+		err := systemSet.add(Configure(func() { skipped++ }).RunIf(func() bool { return false }), &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+		err = systemSet.add(func() { ran++ }, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Equal(0, skipped)
+		assert.Equal(1, ran)
+	})
+
+	t.Run("condition can use queries", func(t *testing.T) {
+		type componentA struct{ ecs.Component }
+
+		assert := assert.New(t)
+
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+
+		counter := 0
+		systemSet := SystemSet{}
+		err := systemSet.add(
+			Configure(func() { counter++ }).RunIf(func(query *ecs.Query1[componentA, ecs.Default]) bool {
+				return query.Result().NumberOfResult() > 0
+			}),
+			&world, nil, &logger, &resourceStorage,
+		)
+		assert.NoError(err)
+
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Equal(0, counter)
+
+		_, err = ecs.Spawn(&world, &componentA{})
+		assert.NoError(err)
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Equal(1, counter)
+	})
+
+	t.Run("queries of skipped systems do not miss changes", func(t *testing.T) {
+		type componentA struct{ ecs.Component }
+
+		assert := assert.New(t)
+
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+
+		shouldRun := false
+		numberOfResults := uint(0)
+		systemSet := SystemSet{}
+		err := systemSet.add(
+			Configure(func(query *ecs.Query1[componentA, ecs.QueryOptions[ecs.Added[componentA], ecs.NoOptional, ecs.AllReadOnly, ecs.NotLazy, ecs.DefaultWorld]]) {
+				numberOfResults = query.Result().NumberOfResult()
+			}).RunIf(func() bool { return shouldRun }),
+			&world, nil, &logger, &resourceStorage,
+		)
+		assert.NoError(err)
+
+		_, err = ecs.Spawn(&world, &componentA{})
+		assert.NoError(err)
+		assert.Empty(systemSet.Exec(&world, nil))
+
+		shouldRun = true
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Equal(uint(1), numberOfResults)
+	})
+
+	t.Run("returns an error if the condition does not return a bool", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+
+		conditions := []RunCondition{
+			1,
+			func() {},
+			func() int { return 1 },
+			func() (bool, error) { return true, nil },
+		}
+
+		for _, condition := range conditions {
+			systemSet := SystemSet{}
+			err := systemSet.add(Configure(func() {}).RunIf(condition), &world, nil, &logger, &resourceStorage)
+			assert.ErrorIs(err, ErrRunConditionNotValid)
+		}
+	})
+
+	t.Run("returns an error if a condition param is not valid", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+
+		systemSet := SystemSet{}
+		err := systemSet.add(Configure(func() {}).RunIf(func(_ gameState) bool { return true }), &world, nil, &logger, &resourceStorage)
+		assert.ErrorIs(err, ErrSystemParamNotValid)
+	})
+
+	t.Run("none of the systems run when a condition of the system set returns false", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+		state := &gameState{paused: true}
+		err := resourceStorage.add(state)
+		assert.NoError(err)
+
+		counterA, counterB := 0, 0
+		systemSet := SystemSet{}
+		err = systemSet.add(func() { counterA++ }, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+		err = systemSet.add(func() { counterB++ }, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+		err = systemSet.addRunCondition(func(state *gameState) bool { return !state.paused }, &world, nil, &logger, &resourceStorage)
+		assert.NoError(err)
+
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Equal(0, counterA)
+		assert.Equal(0, counterB)
+
+		state.paused = false
+		assert.Empty(systemSet.Exec(&world, nil))
+		assert.Equal(1, counterA)
+		assert.Equal(1, counterB)
+	})
+}
@@ -0,0 +1,58 @@
+package app
+
+// SystemLabel identifies one or more systems in a schedule, so that other systems can be ordered before or after them.
+type SystemLabel string
+
+// ConfiguredSystem is a system with extra configuration, such as labels and ordering constraints. It can be used
+// anywhere a System can be used.
+//
+//	app.AddSystem(update, app.Configure(move).Label("move").After("input"))
+type ConfiguredSystem struct {
+	system     System
+	labels     []SystemLabel
+	before     []SystemLabel
+	after      []SystemLabel
+	conditions []RunCondition
+}
+
+// Configure returns a ConfiguredSystem for system that can be used to configure it.
+func Configure(system System) *ConfiguredSystem {
+	return &ConfiguredSystem{system: system}
+}
+
+// Label adds a label to the system. Multiple systems can have the same label, in which case ordering constraints
+// that use that label apply to all of them.
+func (s *ConfiguredSystem) Label(label SystemLabel) *ConfiguredSystem {
+	s.labels = append(s.labels, label)
+	return s
+}
+
+// Before makes the system run before all systems of the same schedule that have the given label.
+func (s *ConfiguredSystem) Before(label SystemLabel) *ConfiguredSystem {
+	s.before = append(s.before, label)
+	return s
+}
+
+// After makes the system run after all systems of the same schedule that have the given label.
+func (s *ConfiguredSystem) After(label SystemLabel) *ConfiguredSystem {
+	s.after = append(s.after, label)
+	return s
+}
+
+// RunIf makes the system only run on ticks where condition returns true. The params of condition are resolved
+// the same way as the params of a system. If RunIf is called multiple times, all conditions must return true.
+//
+//	app.Configure(move).RunIf(func(state *GameState) bool { return !state.paused })
+func (s *ConfiguredSystem) RunIf(condition RunCondition) *ConfiguredSystem {
+	s.conditions = append(s.conditions, condition)
+	return s
+}
+
+// unwrapSystem returns the system and its configuration. The configuration is empty if system is not a ConfiguredSystem.
+func unwrapSystem(system System) (System, ConfiguredSystem) {
+	if configured, ok := system.(*ConfiguredSystem); ok && configured != nil {
+		return configured.system, *configured
+	}
+
+	return system, ConfiguredSystem{system: system}
+}
@@ -0,0 +1,142 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// sortSystems orders the systems so that all Before and After constraints are met. Systems that have no
+// constraints between them keep the order in which they were added. The stages are rebuilt afterwards, so that
+// constrained systems never run in parallel.
+//
+// Can return the following errors:
+//   - ErrSystemLabelNotFound if a constraint uses a label that no system in this SystemSet has.
+//   - ErrSystemOrderCycle if the constraints contradict each other.
+func (s *SystemSet) sortSystems() error {
+	numberOfSystems := len(s.systems)
+
+	labelToSystems := map[SystemLabel][]int{}
+	for i, entry := range s.systems {
+		for _, label := range entry.labels {
+			labelToSystems[label] = append(labelToSystems[label], i)
+		}
+	}
+
+	// predecessors[i] are the indices of the systems that must run before system i
+	predecessors := make([][]int, numberOfSystems)
+	for i, entry := range s.systems {
+		for _, label := range entry.before {
+			systems, ok := labelToSystems[label]
+			if !ok {
+				return fmt.Errorf("%w: system %s runs before label %s", ErrSystemLabelNotFound, entry.name, label)
+			}
+			for _, system := range systems {
+				if system != i {
+					predecessors[system] = append(predecessors[system], i)
+				}
+			}
+		}
+
+		for _, label := range entry.after {
+			systems, ok := labelToSystems[label]
+			if !ok {
+				return fmt.Errorf("%w: system %s runs after label %s", ErrSystemLabelNotFound, entry.name, label)
+			}
+			for _, system := range systems {
+				if system != i {
+					predecessors[i] = append(predecessors[i], system)
+				}
+			}
+		}
+	}
+
+	order := make([]int, 0, numberOfSystems)
+	isOrdered := make([]bool, numberOfSystems)
+	for len(order) < numberOfSystems {
+		next := -1
+		for i := range numberOfSystems {
+			if !isOrdered[i] && s.allOrdered(predecessors[i], isOrdered) {
+				next = i
+				break
+			}
+		}
+
+		if next == -1 {
+			return fmt.Errorf("%w: %s", ErrSystemOrderCycle, s.describeCycle(predecessors, isOrdered))
+		}
+
+		isOrdered[next] = true
+		order = append(order, next)
+	}
+
+	newIndices := make([]int, numberOfSystems)
+	for newIndex, oldIndex := range order {
+		newIndices[oldIndex] = newIndex
+	}
+
+	systems := s.systems
+	s.systems = make([]systemEntry, 0, numberOfSystems)
+	s.stages = nil
+	for _, oldIndex := range order {
+		minStage := 0
+		for _, predecessor := range predecessors[oldIndex] {
+			minStage = max(minStage, s.systems[newIndices[predecessor]].stage+1)
+		}
+
+		s.addEntry(systems[oldIndex], minStage)
+	}
+
+	return nil
+}
+
+func (s *SystemSet) allOrdered(systems []int, isOrdered []bool) bool {
+	for _, system := range systems {
+		if !isOrdered[system] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// describeCycle returns the names of the systems of a cycle, in the order that they would have to run. Every
+// system that is not ordered yet has at least one predecessor that is not ordered yet, so following those
+// predecessors always leads to a cycle.
+func (s *SystemSet) describeCycle(predecessors [][]int, isOrdered []bool) string {
+	current := 0
+	for isOrdered[current] {
+		current++
+	}
+
+	visitedAt := map[int]int{}
+	path := []int{}
+	for {
+		if start, visited := visitedAt[current]; visited {
+			path = path[start:]
+			break
+		}
+
+		visitedAt[current] = len(path)
+		path = append(path, current)
+
+		for _, predecessor := range predecessors[current] {
+			if !isOrdered[predecessor] {
+				current = predecessor
+				break
+			}
+		}
+	}
+
+	// reverse the path so that it is in order of execution, and start at the system that was added first
+	slices.Reverse(path)
+	first := slices.Index(path, slices.Min(path))
+	path = append(path[first:], path[:first+1]...)
+
+	names := make([]string, len(path))
+	for i, system := range path {
+		names[i] = s.systems[system].name
+	}
+
+	return strings.Join(names, " -> ")
+}
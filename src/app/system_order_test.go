@@ -0,0 +1,98 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/lucdrenth/murphecs/src/ecs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortSystems(t *testing.T) {
+	// systems with names so that the error messages can be asserted
+	newSystemSet := func(systems ...System) (*SystemSet, error) {
+		systemSet := SystemSet{}
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+		resourceStorage := newResourceStorage()
+
+		for _, system := range systems {
+			if err := systemSet.add(system, &world, nil, &logger, &resourceStorage); err != nil {
+				return nil, err
+			}
+		}
+
+		return &systemSet, systemSet.sortSystems()
+	}
+
+	t.Run("keeps the order in which systems were added if there are no constraints", func(t *testing.T) {
+		assert := assert.New(t)
+
+		order := []string{}
+		systemSet, err := newSystemSet(
+			func(_ *ecs.World) { order = append(order, "a") },
+			func(_ *ecs.World) { order = append(order, "b") },
+			func(_ *ecs.World) { order = append(order, "c") },
+		)
+		assert.NoError(err)
+
+		assert.Empty(systemSet.Exec(&ecs.World{}, nil))
+		assert.Equal([]string{"a", "b", "c"}, order)
+	})
+
+	t.Run("orders systems by Before and After constraints", func(t *testing.T) {
+		assert := assert.New(t)
+
+		order := []string{}
+		systemSet, err := newSystemSet(
+			Configure(func(_ *ecs.World) { order = append(order, "render") }).Label("render").After("move"),
+			Configure(func(_ *ecs.World) { order = append(order, "move") }).Label("move"),
+			Configure(func(_ *ecs.World) { order = append(order, "input") }).Before("move"),
+		)
+		assert.NoError(err)
+
+		assert.Empty(systemSet.Exec(&ecs.World{}, nil))
+		assert.Equal([]string{"input", "move", "render"}, order)
+	})
+
+	t.Run("constraints apply to all systems with the label", func(t *testing.T) {
+		assert := assert.New(t)
+
+		systemSet, err := newSystemSet(
+			Configure(func() {}).After("physics"),
+			Configure(func() {}).Label("physics"),
+			Configure(func() {}).Label("physics"),
+		)
+		assert.NoError(err)
+
+		// the systems do not conflict, but the constraint prevents them from running in parallel
+		assert.Equal([][]int{{0, 1}, {2}}, systemSet.stages)
+	})
+
+	t.Run("returns an error if a label does not exist", func(t *testing.T) {
+		assert := assert.New(t)
+
+		_, err := newSystemSet(
+			Configure(func() {}).After("physics"),
+		)
+		assert.ErrorIs(err, ErrSystemLabelNotFound)
+		assert.ErrorContains(err, "physics")
+		assert.ErrorContains(err, "TestSortSystems")
+	})
+
+	t.Run("returns an error naming the systems of a cycle", func(t *testing.T) {
+		assert := assert.New(t)
+
+		_, err := newSystemSet(
+			Configure(func() {}),
+			Configure(systemA).Label("a").After("c"),
+			Configure(systemB).Label("b").After("a"),
+			Configure(systemC).Label("c").After("b"),
+		)
+		assert.ErrorIs(err, ErrSystemOrderCycle)
+		assert.ErrorContains(err, "murphecs/app.systemA -> murphecs/app.systemB -> murphecs/app.systemC -> murphecs/app.systemA")
+	})
+}
+
+func systemA() {}
+func systemB() {}
+func systemC() {}
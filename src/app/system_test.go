@@ -164,6 +164,20 @@ func TestAddSystem(t *testing.T) {
 
 		err := systemSet.add(func(_ resourceA) {}, &world, nil, &logger, &resourceStorage)
 		assert.ErrorIs(err, ErrSystemParamNotValid)
+		err = systemSet.add(func(_ int) {}, &world, nil, &logger, &resourceStorage)
+		assert.ErrorIs(err, ErrSystemParamNotValid)
+	})
+
+	t.Run("returns an error if a resource is used without a resource storage", func(t *testing.T) {
+		type resourceA struct{}
+		assert := assert.New(t)
+
+		systemSet := SystemSet{}
+		world := ecs.NewDefaultWorld()
+		logger := NoOpLogger{}
+
+		err := systemSet.add(func(_ resourceA) {}, &world, nil, &logger, nil)
+		assert.ErrorIs(err, ErrSystemParamNotValid)
 	})
 
 	t.Run("can use a resource as system param by value", func(t *testing.T) {
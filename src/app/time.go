@@ -0,0 +1,111 @@
+package app
+
+import (
+	"reflect"
+	"time"
+)
+
+// Time is a reserved resource that keeps track of the time of the repeated systems. It is updated at the start of
+// every run of the repeated systems, regardless of which runner is used.
+//
+// Use it as a system param by value to read it, or by reference to change its scale or to pause it:
+//
+//	func move(time app.Time) { ... time.DeltaSeconds() ... }
+type Time struct {
+	delta   time.Duration
+	elapsed time.Duration
+	ticks   uint
+	scale   float64
+	paused  bool
+}
+
+func newTime() *Time {
+	return &Time{scale: 1}
+}
+
+// Delta returns the time that passed since the previous tick, multiplied by the time scale. It is zero while paused.
+func (t *Time) Delta() time.Duration {
+	return t.delta
+}
+
+// DeltaSeconds returns Delta in seconds.
+func (t *Time) DeltaSeconds() float64 {
+	return t.delta.Seconds()
+}
+
+// Elapsed returns the sum of the deltas of all ticks.
+func (t *Time) Elapsed() time.Duration {
+	return t.elapsed
+}
+
+// Ticks returns the number of ticks that have run, including the current tick. Ticks are counted while paused.
+func (t *Time) Ticks() uint {
+	return t.ticks
+}
+
+// Scale returns the factor with which the delta of each tick is multiplied.
+func (t *Time) Scale() float64 {
+	return t.scale
+}
+
+// SetScale sets the factor with which the delta of each tick is multiplied. For example, 0.5 makes time pass half as
+// fast. It is picked up from the next tick. Negative scales are treated as 0.
+func (t *Time) SetScale(scale float64) {
+	t.scale = max(scale, 0)
+}
+
+// Pause makes time stop passing from the next tick, until Unpause is called.
+func (t *Time) Pause() {
+	t.paused = true
+}
+
+// Unpause makes time pass again from the next tick.
+func (t *Time) Unpause() {
+	t.paused = false
+}
+
+// IsPaused returns wether time is paused.
+func (t *Time) IsPaused() bool {
+	return t.paused
+}
+
+// advance starts a new tick in which delta time has passed.
+func (t *Time) advance(delta time.Duration) {
+	t.ticks++
+
+	if t.paused {
+		t.delta = 0
+		return
+	}
+
+	t.delta = time.Duration(float64(delta) * t.scale)
+	t.elapsed += t.delta
+}
+
+// simulatedTimeRunner is a Runner that simulates a fixed amount of time on every run of the repeated systems, instead
+// of the time that actually passed.
+type simulatedTimeRunner interface {
+	simulatedDelta() time.Duration
+}
+
+// newUpdateTimeSystemSet returns a SystemSet that advances t with the time that passed on clock since the previous
+// run. If runner is a simulatedTimeRunner, t is advanced with its simulated delta instead. It is run before all
+// repeated systems so that custom runners update the time without having to be aware of it.
+func newUpdateTimeSystemSet(t *Time, clock Clock, runner Runner) *SystemSet {
+	simulatedTimeRunner, isSimulated := runner.(simulatedTimeRunner)
+	lastRun := clock.Now()
+
+	systemSet := &SystemSet{}
+	systemSet.addEntry(systemEntry{system: reflect.ValueOf(func() {
+		now := clock.Now()
+		delta := now.Sub(lastRun)
+		lastRun = now
+
+		if isSimulated {
+			delta = simulatedTimeRunner.simulatedDelta()
+		}
+
+		t.advance(delta)
+	})}, 0)
+	return systemSet
+}
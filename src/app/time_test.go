@@ -0,0 +1,94 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lucdrenth/murphecs/src/ecs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTime(t *testing.T) {
+	t.Run("advances delta, elapsed and ticks", func(t *testing.T) {
+		assert := assert.New(t)
+
+		appTime := newTime()
+		appTime.advance(time.Second)
+		appTime.advance(2 * time.Second)
+
+		assert.Equal(2*time.Second, appTime.Delta())
+		assert.Equal(2.0, appTime.DeltaSeconds())
+		assert.Equal(3*time.Second, appTime.Elapsed())
+		assert.Equal(uint(2), appTime.Ticks())
+	})
+
+	t.Run("multiplies delta by the scale", func(t *testing.T) {
+		assert := assert.New(t)
+
+		appTime := newTime()
+		appTime.SetScale(0.5)
+		appTime.advance(time.Second)
+
+		assert.Equal(500*time.Millisecond, appTime.Delta())
+		assert.Equal(500*time.Millisecond, appTime.Elapsed())
+
+		appTime.SetScale(-1)
+		assert.Equal(0.0, appTime.Scale())
+	})
+
+	t.Run("does not pass time while paused", func(t *testing.T) {
+		assert := assert.New(t)
+
+		appTime := newTime()
+		appTime.advance(time.Second)
+		appTime.Pause()
+		appTime.advance(time.Second)
+
+		assert.True(appTime.IsPaused())
+		assert.Equal(time.Duration(0), appTime.Delta())
+		assert.Equal(time.Second, appTime.Elapsed())
+		assert.Equal(uint(2), appTime.Ticks())
+
+		appTime.Unpause()
+		appTime.advance(time.Second)
+		assert.Equal(time.Second, appTime.Delta())
+		assert.Equal(2*time.Second, appTime.Elapsed())
+	})
+
+	t.Run("is updated on every tick and can be used as system param", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+
+		clock := NewVirtualClock(time.Time{})
+		app.SetClock(clock)
+
+		deltas := []time.Duration{}
+		app.AddSchedule(testSchedule, ScheduleTypeRepeating)
+		app.AddSystem(testSchedule, func(appTime Time) { deltas = append(deltas, appTime.Delta()) })
+
+		app.Startup()
+		clock.Advance(time.Second)
+		app.Step(1)
+		clock.Advance(time.Millisecond)
+		app.Step(1)
+
+		assert.Equal([]time.Duration{time.Second, time.Millisecond}, deltas)
+		assert.Equal(0.001, app.Delta())
+		assert.Equal(uint(0), logger.err)
+	})
+
+	t.Run("is reserved", func(t *testing.T) {
+		assert := assert.New(t)
+
+		logger := testLogger{}
+		app, err := New(&logger, ecs.DefaultWorldConfigs())
+		assert.NoError(err)
+
+		app.AddResource(&Time{})
+		assert.Equal(uint(1), logger.err)
+		assert.Equal(uint(0), app.NumberOfResources())
+	})
+}
@@ -2,6 +2,7 @@ package ecs
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strconv"
 
@@ -10,7 +11,7 @@ import (
 
 type archetypeStorage struct {
 	componentsHashToArchetype map[string]*Archetype // this map stores a list of unique Archetype
-	entityIdToArchetype       map[EntityId]*Archetype
+	archetypes                []*Archetype          // all archetypes in the order they were created
 	componentIdToArchetypes   map[ComponentId]*[]*Archetype
 	idCounter                 uint
 }
@@ -18,13 +19,12 @@ type archetypeStorage struct {
 func newArchetypeStorage() archetypeStorage {
 	return archetypeStorage{
 		componentsHashToArchetype: map[string]*Archetype{},
-		entityIdToArchetype:       map[EntityId]*Archetype{},
 		componentIdToArchetypes:   map[ComponentId]*[]*Archetype{},
 	}
 }
 
 // getArchetype either returns an existing archetype or creates a new one if it doesn't exist yet.
-func (s archetypeStorage) getArchetype(world *World, componentIds []ComponentId) (*Archetype, error) {
+func (s *archetypeStorage) getArchetype(world *World, componentIds []ComponentId) (*Archetype, error) {
 	sortComponentIds(componentIds)
 	hash := hashComponentIds(componentIds)
 	existingArchetype, exists := s.componentsHashToArchetype[hash]
@@ -38,6 +38,7 @@ func (s archetypeStorage) getArchetype(world *World, componentIds []ComponentId)
 	}
 
 	s.componentsHashToArchetype[hash] = newArchetype
+	s.archetypes = append(s.archetypes, newArchetype)
 
 	for i := range componentIds {
 		archetypeList, exists := s.componentIdToArchetypes[componentIds[i]]
@@ -51,6 +52,54 @@ func (s archetypeStorage) getArchetype(world *World, componentIds []ComponentId)
 	return newArchetype, nil
 }
 
+// getArchetypeWithAddedComponents returns the archetype that an entity of archetype from moves to when
+// addedComponentIds are inserted. The result is cached as an edge of from, so that subsequent moves do not have
+// to sort and hash the full list of component ids.
+//
+// newComponentIds must contain the components of from, addedComponentIds and any required components. It
+// is only used when the edge is not known yet.
+func (s *archetypeStorage) getArchetypeWithAddedComponents(world *World, from *Archetype, addedComponentIds []ComponentId, newComponentIds []ComponentId) (*Archetype, error) {
+	edge := hashEdgeComponentIds(addedComponentIds)
+	if to, exists := from.addEdges[edge]; exists {
+		return to, nil
+	}
+
+	to, err := s.getArchetype(world, newComponentIds)
+	if err != nil {
+		return nil, err
+	}
+
+	from.addEdges[edge] = to
+	return to, nil
+}
+
+// getArchetypeWithRemovedComponents returns the archetype that an entity of archetype from moves to when
+// removedComponentIds are removed. The result is cached as an edge of from, so that subsequent moves do not
+// have to sort and hash the full list of component ids.
+//
+// All removedComponentIds must be present in from.
+func (s *archetypeStorage) getArchetypeWithRemovedComponents(world *World, from *Archetype, removedComponentIds []ComponentId) (*Archetype, error) {
+	edge := hashEdgeComponentIds(removedComponentIds)
+	if to, exists := from.removeEdges[edge]; exists {
+		return to, nil
+	}
+
+	newComponentIds := make([]ComponentId, 0, len(from.componentIds))
+	for _, componentId := range from.componentIds {
+		if !slices.Contains(removedComponentIds, componentId) {
+			newComponentIds = append(newComponentIds, componentId)
+		}
+	}
+
+	to, err := s.getArchetype(world, newComponentIds)
+	if err != nil {
+		return nil, err
+	}
+
+	from.removeEdges[edge] = to
+	return to, nil
+}
+
 // countComponents returns the number of living components
 func (storage *archetypeStorage) countComponents() uint {
 	count := uint(0)
@@ -68,6 +117,10 @@ type Archetype struct {
 	components         map[ComponentId]*componentStorage
 	componentIds       []ComponentId
 	entities           []EntityId
+
+	// Edges to neighbouring archetypes, keyed by the hash of the component ids that are added or removed.
+	addEdges    map[string]*Archetype
+	removeEdges map[string]*Archetype
 }
 
 // newArchetype returns a new archetype for the given componentIds.
@@ -93,6 +146,8 @@ func newArchetype(world *World, componentIds []ComponentId) (*Archetype, error)
 		componentTypesHash: hashComponentIds(componentIds),
 		components:         components,
 		componentIds:       componentIds,
+		addEdges:           map[string]*Archetype{},
+		removeEdges:        map[string]*Archetype{},
 	}, nil
 }
 
@@ -132,6 +187,17 @@ func sortComponentIds(componentIds []ComponentId) {
 	})
 }
 
+// hashEdgeComponentIds returns the same hash as hashComponentIds, but without changing the order of componentIds.
+func hashEdgeComponentIds(componentIds []ComponentId) string {
+	if len(componentIds) <= 1 {
+		return hashComponentIds(componentIds)
+	}
+
+	sorted := slices.Clone(componentIds)
+	sortComponentIds(sorted)
+	return hashComponentIds(sorted)
+}
+
 // hashComponentIds returns a unique hash for every different combination of component id's.
 // This function is deterministic, meaning the same input results in the same output.
 func hashComponentIds(componentIds []ComponentId) string {
@@ -2,6 +2,7 @@ package ecs
 
 import (
 	"fmt"
+	"slices"
 	"testing"
 )
 
@@ -86,3 +87,66 @@ func BenchmarkHashComponentIds(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkGetArchetypeForMove(b *testing.B) {
+	type addedComponent struct{ Component }
+	type component1 struct{ Component }
+	type component2 struct{ Component }
+	type component3 struct{ Component }
+	type component4 struct{ Component }
+	type component5 struct{ Component }
+	type component6 struct{ Component }
+	type component7 struct{ Component }
+	type component8 struct{ Component }
+	type component9 struct{ Component }
+	type component10 struct{ Component }
+	type component11 struct{ Component }
+	type component12 struct{ Component }
+	type component13 struct{ Component }
+	type component14 struct{ Component }
+	type component15 struct{ Component }
+	type component16 struct{ Component }
+
+	world := NewDefaultWorld()
+
+	addedComponentIds := []ComponentId{ComponentIdFor[addedComponent](&world)}
+	allComponentIds := []ComponentId{
+		ComponentIdFor[component1](&world),
+		ComponentIdFor[component2](&world),
+		ComponentIdFor[component3](&world),
+		ComponentIdFor[component4](&world),
+		ComponentIdFor[component5](&world),
+		ComponentIdFor[component6](&world),
+		ComponentIdFor[component7](&world),
+		ComponentIdFor[component8](&world),
+		ComponentIdFor[component9](&world),
+		ComponentIdFor[component10](&world),
+		ComponentIdFor[component11](&world),
+		ComponentIdFor[component12](&world),
+		ComponentIdFor[component13](&world),
+		ComponentIdFor[component14](&world),
+		ComponentIdFor[component15](&world),
+		ComponentIdFor[component16](&world),
+	}
+
+	for _, numberOfComponents := range []int{1, 4, 10, 16} {
+		from, err := world.archetypeStorage.getArchetype(&world, slices.Clone(allComponentIds[:numberOfComponents]))
+		if err != nil {
+			b.FailNow()
+		}
+
+		newComponentIds := append(slices.Clone(allComponentIds[:numberOfComponents]), addedComponentIds...)
+
+		b.Run(fmt.Sprintf("ByHash-Components-%d", numberOfComponents), func(b *testing.B) {
+			for b.Loop() {
+				world.archetypeStorage.getArchetype(&world, newComponentIds)
+			}
+		})
+
+		b.Run(fmt.Sprintf("ByEdge-Components-%d", numberOfComponents), func(b *testing.B) {
+			for b.Loop() {
+				world.archetypeStorage.getArchetypeWithAddedComponents(&world, from, addedComponentIds, newComponentIds)
+			}
+		})
+	}
+}
@@ -169,3 +169,61 @@ func TestArchetypeIsFromComponents(t *testing.T) {
 		})
 	}
 }
+
+func TestArchetypeEdges(t *testing.T) {
+	type componentA struct{ Component }
+	type componentB struct{ Component }
+	type componentC struct{ Component }
+
+	t.Run("inserting and removing a component caches the edges between archetypes", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		entity, err := Spawn(&world, &componentA{}, &componentB{})
+		assert.NoError(err)
+		entityData, _ := world.getEntityData(entity)
+		archetypeAB := entityData.archetype
+
+		err = Insert(&world, entity, &componentC{})
+		assert.NoError(err)
+		entityData, _ = world.getEntityData(entity)
+		archetypeABC := entityData.archetype
+
+		err = Remove1[componentC](&world, entity)
+		assert.NoError(err)
+		entityData, _ = world.getEntityData(entity)
+		assert.Same(archetypeAB, entityData.archetype)
+
+		edge := hashEdgeComponentIds([]ComponentId{ComponentIdFor[componentC](&world)})
+		assert.Same(archetypeABC, archetypeAB.addEdges[edge])
+		assert.Same(archetypeAB, archetypeABC.removeEdges[edge])
+		assert.Equal(2, world.CountArchetypes())
+	})
+
+	t.Run("edges give the same archetype as getting it from the full list of components", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		componentIdA := ComponentIdFor[componentA](&world)
+		componentIdB := ComponentIdFor[componentB](&world)
+		componentIdC := ComponentIdFor[componentC](&world)
+
+		from, err := world.archetypeStorage.getArchetype(&world, []ComponentId{componentIdA})
+		assert.NoError(err)
+
+		for range 2 {
+			to, err := world.archetypeStorage.getArchetypeWithAddedComponents(&world, from, []ComponentId{componentIdC, componentIdB}, []ComponentId{componentIdA, componentIdB, componentIdC})
+			assert.NoError(err)
+			expected, err := world.archetypeStorage.getArchetype(&world, []ComponentId{componentIdB, componentIdC, componentIdA})
+			assert.NoError(err)
+			assert.Same(expected, to)
+
+			back, err := world.archetypeStorage.getArchetypeWithRemovedComponents(&world, to, []ComponentId{componentIdB, componentIdC})
+			assert.NoError(err)
+			assert.Same(from, back)
+		}
+
+		assert.Len(from.addEdges, 1)
+		assert.Equal(2, world.CountArchetypes())
+	})
+}
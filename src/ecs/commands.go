@@ -0,0 +1,97 @@
+// deferred structural changes to a world
+package ecs
+
+import (
+	"errors"
+	"fmt"
+)
+
+type command func(world *World) error
+
+// Commands records structural changes, such as spawning and deleting entities, so that they can be applied to a
+// world at a later moment by calling Apply.
+//
+// This is useful when ranging over query results, because structural changes move components in their storages
+// and thereby invalidate the component pointers of the query results.
+//
+// Components that are passed to Commands must not be altered before Apply is called.
+type Commands struct {
+	commands []command
+}
+
+// Spawn records spawning an entity with the given components. See [Spawn].
+func (commands *Commands) Spawn(components ...IComponent) {
+	commands.add(func(world *World) error {
+		_, err := Spawn(world, components...)
+		return err
+	})
+}
+
+// Insert records inserting the given components in to entity. See [Insert].
+func (commands *Commands) Insert(entity EntityId, components ...IComponent) {
+	commands.add(func(world *World) error {
+		return Insert(world, entity, components...)
+	})
+}
+
+// InsertOrOverwrite records inserting or overwriting the given components of entity. See [InsertOrOverwrite].
+func (commands *Commands) InsertOrOverwrite(entity EntityId, components ...IComponent) {
+	commands.add(func(world *World) error {
+		return InsertOrOverwrite(world, entity, components...)
+	})
+}
+
+// Delete records deleting entity. See [Delete].
+func (commands *Commands) Delete(entity EntityId) {
+	commands.add(func(world *World) error {
+		return Delete(world, entity)
+	})
+}
+
+// DeleteRecursive records deleting entity and all of its descendants. See [DeleteRecursive].
+func (commands *Commands) DeleteRecursive(entity EntityId) {
+	commands.add(func(world *World) error {
+		return DeleteRecursive(world, entity)
+	})
+}
+
+// SetParent records making parent the parent of child. See [SetParent].
+func (commands *Commands) SetParent(child EntityId, parent EntityId) {
+	commands.add(func(world *World) error {
+		return SetParent(world, child, parent)
+	})
+}
+
+// Run records a custom function that gets called with the world on Apply.
+func (commands *Commands) Run(f func(world *World) error) {
+	commands.add(f)
+}
+
+func (commands *Commands) add(command command) {
+	commands.commands = append(commands.commands, command)
+}
+
+// Len returns the number of recorded commands.
+func (commands *Commands) Len() int {
+	return len(commands.commands)
+}
+
+// Apply applies all recorded commands to world in the order that they were recorded, and clears them
+// afterwards. Commands that fail do not stop the other commands from being applied.
+//
+// Returns all errors of the commands that failed, joined together.
+func (commands *Commands) Apply(world *World) error {
+	errs := []error{}
+
+	// Commands that are recorded during Apply, for example from an observer, are applied as well.
+	for i := 0; i < len(commands.commands); i++ {
+		if err := commands.commands[i](world); err != nil {
+			errs = append(errs, fmt.Errorf("command %d failed: %w", i+1, err))
+		}
+	}
+
+	clear(commands.commands)
+	commands.commands = commands.commands[:0]
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,152 @@
+package ecs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommands(t *testing.T) {
+	type componentA struct {
+		Component
+		value int
+	}
+	type componentB struct{ Component }
+
+	t.Run("commands are not applied until Apply is called", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		commands := Commands{}
+		commands.Spawn(&componentA{})
+		commands.Spawn(&componentA{})
+		assert.Equal(2, commands.Len())
+		assert.Equal(0, world.CountEntities())
+
+		err := commands.Apply(&world)
+		assert.NoError(err)
+		assert.Equal(2, world.CountEntities())
+		assert.Equal(0, commands.Len())
+
+		// commands are cleared after applying
+		err = commands.Apply(&world)
+		assert.NoError(err)
+		assert.Equal(2, world.CountEntities())
+	})
+
+	t.Run("structural changes can be made while ranging over query results", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		for i := range 10 {
+			_, err := Spawn(&world, &componentA{value: i})
+			assert.NoError(err)
+		}
+
+		query := Query1[componentA, Default]{}
+		err := query.Prepare(&world)
+		assert.NoError(err)
+		err = query.Exec(&world)
+		assert.NoError(err)
+
+		commands := Commands{}
+		err = query.Result().Iter(func(entity EntityId, a *componentA) error {
+			if a.value%2 == 0 {
+				commands.Delete(entity)
+			} else {
+				commands.Insert(entity, &componentB{})
+				commands.Spawn(&componentB{})
+			}
+			a.value += 100
+			return nil
+		})
+		assert.NoError(err)
+
+		err = commands.Apply(&world)
+		assert.NoError(err)
+		assert.Equal(10, world.CountEntities())
+
+		err = query.Exec(&world)
+		assert.NoError(err)
+		values := []int{}
+		for a := range query.Result().Range() {
+			values = append(values, a.value)
+		}
+		assert.ElementsMatch([]int{101, 103, 105, 107, 109}, values)
+	})
+
+	t.Run("removes components", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		entity, err := Spawn(&world, &componentA{}, &componentB{})
+		assert.NoError(err)
+
+		commands := Commands{}
+		CommandsRemove1[componentB](&commands, entity)
+		err = commands.Apply(&world)
+		assert.NoError(err)
+
+		hasB, err := HasComponent[componentB](&world, entity)
+		assert.NoError(err)
+		assert.False(hasB)
+		hasA, err := HasComponent[componentA](&world, entity)
+		assert.NoError(err)
+		assert.True(hasA)
+	})
+
+	t.Run("removes multiple components", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		entity, err := Spawn(&world, &componentA{}, &componentB{})
+		assert.NoError(err)
+
+		commands := Commands{}
+		CommandsRemove2[componentA, componentB](&commands, entity)
+		err = commands.Apply(&world)
+		assert.NoError(err)
+
+		hasA, err := HasComponent[componentA](&world, entity)
+		assert.NoError(err)
+		assert.False(hasA)
+		hasB, err := HasComponent[componentB](&world, entity)
+		assert.NoError(err)
+		assert.False(hasB)
+	})
+
+	t.Run("failing commands do not stop other commands from being applied", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		entity, err := Spawn(&world, &componentA{})
+		assert.NoError(err)
+		err = Delete(&world, entity)
+		assert.NoError(err)
+
+		commands := Commands{}
+		commands.Delete(entity)
+		commands.Spawn(&componentA{})
+		CommandsRemove1[componentB](&commands, entity)
+
+		err = commands.Apply(&world)
+		assert.ErrorIs(err, ErrEntityNotFound)
+		assert.Equal(1, world.CountEntities())
+	})
+
+	t.Run("commands that are recorded while applying are applied as well", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		commands := Commands{}
+		commands.Run(func(world *World) error {
+			commands.Spawn(&componentA{})
+			return nil
+		})
+
+		err := commands.Apply(&world)
+		assert.NoError(err)
+		assert.Equal(1, world.CountEntities())
+		assert.Equal(0, commands.Len())
+	})
+}
@@ -14,9 +14,16 @@ type componentStorage struct {
 	data               reflect.Value  // buffer for storing components
 	componentSize      uintptr        // the amount of memory that each component takes up in data
 	componentId        ComponentId
-	nextItemIndex      uint // the next inserted component will be inserted at this index
-	capacity           uint // the number of components that can be stored with the current size of data
-	numberOfComponents uint // the number of components that this storage contains
+	nextItemIndex      uint             // the next inserted component will be inserted at this index
+	capacity           uint             // the number of components that can be stored with the current size of data
+	numberOfComponents uint             // the number of components that this storage contains
+	ticks              []componentTicks // change ticks of the component at the same index in data
+}
+
+// componentTicks holds the world change ticks at which a component was added and last changed.
+type componentTicks struct {
+	added   uint
+	changed uint
 }
 
 // createComponentStorage creates a new instance of createComponentStorage that can hold [capacity] components of type [ComponentId].
@@ -67,6 +74,8 @@ func (storage *componentStorage) insert(world *World, component IComponent) (uin
 		return 0, err
 	}
 
+	changeTick := world.currentChangeTick()
+	storage.setTicks(insertIndex, componentTicks{added: changeTick, changed: changeTick})
 	storage.nextItemIndex += 1
 	storage.numberOfComponents += 1
 
@@ -98,10 +107,11 @@ func (storage *componentStorage) set(component IComponent, index uint) error {
 	return nil
 }
 
-// insertRaw returns the index at which the component was inserted.
+// insertRaw returns the index at which the component was inserted. The component keeps the given change ticks,
+// because it is not a new component but one that is moved from another storage.
 //
 // Returns an ErrComponentIsNotAPointer when component is not passed as a reference (e.g. componentA{}, instead of &componentA{})
-func (storage *componentStorage) insertRaw(world *World, componentPointer unsafe.Pointer) (uint, error) {
+func (storage *componentStorage) insertRaw(world *World, componentPointer unsafe.Pointer, ticks componentTicks) (uint, error) {
 	insertIndex := storage.nextItemIndex
 
 	if storage.capacity == insertIndex {
@@ -119,6 +129,7 @@ func (storage *componentStorage) insertRaw(world *World, componentPointer unsafe
 	}
 
 	utils.CopyPointerData(componentPointer, destination, storage.componentSize)
+	storage.setTicks(insertIndex, ticks)
 	storage.nextItemIndex += 1
 	storage.numberOfComponents += 1
 
@@ -139,15 +150,17 @@ func (storage *componentStorage) remove(index uint) (error, *movedComponent) {
 	}
 
 	storage.numberOfComponents -= 1
+	lastIndex := storage.nextItemIndex - 1
 
-	if storage.nextItemIndex == 0 || index >= (storage.nextItemIndex-1) {
+	if index == lastIndex {
+		storage.clearLast()
 		return nil, nil
 	}
 
 	// move the last component in the storage to the index of the removed component to reuse the memory block.
 
 	result := movedComponent{
-		fromIndex: storage.nextItemIndex - 1,
+		fromIndex: lastIndex,
 		toIndex:   index,
 	}
 
@@ -156,11 +169,19 @@ func (storage *componentStorage) remove(index uint) (error, *movedComponent) {
 		return fmt.Errorf("failed to move component: %w", err), &result
 	}
 
-	storage.nextItemIndex -= 1
+	storage.clearLast()
 
 	return nil, &result
 }
 
+// clearLast frees the last component in the storage so that its index can be reused. The component is zeroed so
+// that anything it points to can be garbage collected.
+func (storage *componentStorage) clearLast() {
+	storage.nextItemIndex -= 1
+	storage.data.Index(int(storage.nextItemIndex)).SetZero()
+	storage.ticks = storage.ticks[:storage.nextItemIndex]
+}
+
 // copyComponent copies a component from one index in the storage to another. Both indices must already be
 // a valid component, you can not use an empty index.
 func (storage *componentStorage) copyComponent(fromIndex uint, toIndex uint) error {
@@ -189,10 +210,25 @@ func (storage *componentStorage) copyComponent(fromIndex uint, toIndex uint) err
 	}
 
 	utils.CopyPointerData(source, destination, storage.componentSize)
+	storage.ticks[toIndex] = storage.ticks[fromIndex]
 
 	return nil
 }
 
+// setTicks sets the change ticks of the component at index.
+func (storage *componentStorage) setTicks(index uint, ticks componentTicks) {
+	if index >= uint(len(storage.ticks)) {
+		storage.ticks = append(storage.ticks, make([]componentTicks, index-uint(len(storage.ticks))+1)...)
+	}
+
+	storage.ticks[index] = ticks
+}
+
+// markChanged sets the changed tick of the component at index to changeTick.
+func (storage *componentStorage) markChanged(index uint, changeTick uint) {
+	storage.ticks[index].changed = changeTick
+}
+
 // getComponentPointer returns an unsafe.Pointer to the component at index.
 //
 // Returns an error if index is out of bounds.
@@ -286,6 +286,26 @@ func TestRemoveFromComponentStorage(t *testing.T) {
 		assert.Equal(uint(5), movedComponent.toIndex)
 	})
 
+	t.Run("removing the last component frees its index", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		componentStorage, err := createComponentStorage(4, ComponentIdFor[componentA](&world))
+		assert.NoError(err)
+		_, err = componentStorage.insert(&world, &componentA{})
+		assert.NoError(err)
+		index, err := componentStorage.insert(&world, &componentA{})
+		assert.NoError(err)
+
+		err, _ = componentStorage.remove(index)
+		assert.NoError(err)
+		assert.Equal(uint(1), componentStorage.nextItemIndex)
+
+		newIndex, err := componentStorage.insert(&world, &componentA{})
+		assert.NoError(err)
+		assert.Equal(index, newIndex)
+	})
+
 	t.Run("remove makes the component storage reuse memory", func(t *testing.T) {
 		assert := assert.New(t)
 
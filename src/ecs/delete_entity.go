@@ -4,20 +4,58 @@ import "fmt"
 
 // Delete removes an entity from the world.
 //
+// If the entity has a parent, it is removed from the children of its parent. If the entity has children, their
+// parent is removed so that they become root entities. Use DeleteRecursive to also delete the children.
+//
 // Returns an ErrEntityNotFound error if the entity did not exist in the world.
 func Delete(world *World, entity EntityId) error {
-	entityData, ok := world.entities[entity]
+	entityData, ok := world.getEntityData(entity)
 	if !ok {
 		return ErrEntityNotFound
 	}
 
-	err := entityData.archetype.removeEntity(entity)
+	if world.observers.events[observerEventDespawn] > 0 {
+		world.triggerObservers(observerEventDespawn, entity, entityData.archetype.componentIds)
+
+		// observers could have made structural changes to the entity
+		entityData, ok = world.getEntityData(entity)
+		if !ok {
+			return ErrEntityNotFound
+		}
+	}
+
+	if entityData.archetype.HasComponent(ComponentIdFor[Parent](world)) || entityData.archetype.HasComponent(ComponentIdFor[Children](world)) {
+		if err := removeRelationships(world, entity); err != nil {
+			return fmt.Errorf("failed to remove relationships: %w", err)
+		}
+
+		// removing relationships makes structural changes to the entity
+		entityData, ok = world.getEntityData(entity)
+		if !ok {
+			return ErrEntityNotFound
+		}
+	}
+
+	archetype := entityData.archetype
+
+	var movedComponent *movedComponent
+	for _, storage := range archetype.components {
+		err, removeResult := storage.remove(entityData.row)
+		if err != nil {
+			return fmt.Errorf("failed to remove component %s: %w", storage.componentId.DebugString(), err)
+		}
+
+		movedComponent = removeResult
+	}
+
+	handleComponentStorageIndexMove(world, movedComponent, archetype)
+
+	err := archetype.removeEntity(entity)
 	if err != nil {
 		return fmt.Errorf("failed to remove entity from archetype: %w", err)
 	}
 
-	delete(world.archetypeStorage.entityIdToArchetype, entity)
-	delete(world.entities, entity)
+	world.despawnEntity(entity)
 
 	return nil
 }
@@ -38,4 +38,70 @@ func TestDelete(t *testing.T) {
 		_, err = Get1[structA](&world, entity3)
 		assert.NoError(err)
 	})
+
+	t.Run("frees the components of the entity", func(t *testing.T) {
+		type structA struct {
+			Component
+			value int
+		}
+		type structB struct{ Component }
+
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		entity1, err := Spawn(&world, &structA{value: 1}, &structB{})
+		assert.NoError(err)
+		entity2, err := Spawn(&world, &structA{value: 2}, &structB{})
+		assert.NoError(err)
+		entity3, err := Spawn(&world, &structA{value: 3}, &structB{})
+		assert.NoError(err)
+		assert.Equal(6, world.CountComponents())
+
+		err = Delete(&world, entity1)
+		assert.NoError(err)
+		assert.Equal(4, world.CountComponents())
+
+		// entity3 was moved in to the row of entity1
+		a, err := Get1[structA](&world, entity3)
+		assert.NoError(err)
+		assert.Equal(3, a.value)
+		a, err = Get1[structA](&world, entity2)
+		assert.NoError(err)
+		assert.Equal(2, a.value)
+
+		err = Delete(&world, entity3)
+		assert.NoError(err)
+		err = Delete(&world, entity2)
+		assert.NoError(err)
+		assert.Equal(0, world.CountComponents())
+	})
+
+	t.Run("spawning and deleting in a loop keeps memory flat", func(t *testing.T) {
+		type structA struct {
+			Component
+			value *int
+		}
+
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		_, err := Spawn(&world, &structA{})
+		assert.NoError(err)
+		storage := world.archetypeStorage.archetypes[0].components[ComponentIdFor[structA](&world)]
+		capacity := storage.capacity
+		numberOfEntitySlots := len(world.entities)
+
+		for i := range 10_000 {
+			entity, err := Spawn(&world, &structA{value: &i})
+			assert.NoError(err)
+			err = Delete(&world, entity)
+			assert.NoError(err)
+		}
+
+		assert.Equal(capacity, storage.capacity)
+		assert.Equal(uint(1), storage.nextItemIndex)
+		assert.Len(storage.ticks, 1)
+		assert.Equal(numberOfEntitySlots+1, len(world.entities))
+		assert.Equal(1, world.CountComponents())
+	})
 }
@@ -1,14 +1,41 @@
 package ecs
 
-type EntityId = uint
+import "fmt"
 
-// This entityId can never exist in `world` because the inserted entityId's starts at 1.
+// EntityId identifies an entity in a World.
+//
+// It consists of an index in to the entity table of the world and a generation. When an entity is deleted,
+// its index gets reused for a newly spawned entity with an incremented generation. This way an EntityId that
+// is held on to after its entity got deleted will never point to another entity.
+type EntityId struct {
+	index      uint
+	generation uint
+}
+
+// Index returns the index of the entity in the entity table of its world. Indices of deleted entities are reused,
+// so the index alone does not uniquely identify an entity.
+func (entity EntityId) Index() uint {
+	return entity.index
+}
+
+// Generation returns how many times the index of this entity was used by entities that have since been deleted.
+func (entity EntityId) Generation() uint {
+	return entity.generation
+}
+
+func (entity EntityId) String() string {
+	return fmt.Sprintf("%dv%d", entity.index, entity.generation)
+}
+
+// This entityId can never exist in `world` because the index 0 is never handed out.
 // Useful for tests.
-const nonExistingEntity = EntityId(0)
+var nonExistingEntity = EntityId{}
 
 type EntityData struct {
-	archetype *Archetype
-	row       uint // index of archetype its component storages
+	archetype  *Archetype
+	row        uint // index of archetype its component storages
+	generation uint // generation of the entity that currently uses, or that last used, this index
+	isAlive    bool
 }
 
 func (e *EntityData) hasComponent(c ComponentId) bool {
@@ -10,6 +10,8 @@ var (
 	ErrComponentAlreadyPresent error = errors.New("component is already present")
 	ErrComponentIsNotAPointer  error = errors.New("component is not a pointer")
 
+	ErrRelationshipCycle error = errors.New("relationship cycle")
+
 	ErrInvalidComponentStorageCapacity  error = errors.New("invalid component storage capacity")
 	ErrComponentStorageIndexOutOfBounds error = errors.New("component storage index is out of bounds")
 )
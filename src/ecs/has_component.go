@@ -5,7 +5,7 @@ package ecs
 // Can return the following errors:
 //   - Returns an ErrEntityNotFound error if the entity is not found.
 func HasComponent[C IComponent](world *World, entity EntityId) (bool, error) {
-	entityData, exists := world.entities[entity]
+	entityData, exists := world.getEntityData(entity)
 	if !exists {
 		return false, ErrEntityNotFound
 	}
@@ -20,7 +20,7 @@ func HasComponent[C IComponent](world *World, entity EntityId) (bool, error) {
 // Can return the following errors:
 //   - Returns an ErrEntityNotFound error if the entity is not found.
 func HasComponentId(world *World, entity EntityId, componentId ComponentId) (bool, error) {
-	entityData, exists := world.entities[entity]
+	entityData, exists := world.getEntityData(entity)
 	if !exists {
 		return false, ErrEntityNotFound
 	}
@@ -22,7 +22,7 @@ func Insert(world *World, entity EntityId, components ...IComponent) (resultErr
 		return nil
 	}
 
-	entityData, ok := world.entities[entity]
+	entityData, ok := world.getEntityData(entity)
 	if !ok {
 		return ErrEntityNotFound
 	}
@@ -53,10 +53,12 @@ func Insert(world *World, entity EntityId, components ...IComponent) (resultErr
 	}
 
 	// move archetype
-	newComponentIds := append(componentIdsToAdd, oldArchetype.componentIds...)
+	newComponentIds := make([]ComponentId, 0, len(componentIdsToAdd)+len(oldArchetype.componentIds))
+	newComponentIds = append(newComponentIds, componentIdsToAdd...)
+	newComponentIds = append(newComponentIds, oldArchetype.componentIds...)
 	requiredComponents := getAllRequiredComponents(&newComponentIds, componentsToAdd, world)
 
-	newArchetype, err := world.archetypeStorage.getArchetype(world, newComponentIds)
+	newArchetype, err := world.archetypeStorage.getArchetypeWithAddedComponents(world, oldArchetype, componentIdsToAdd, newComponentIds)
 	if err != nil {
 		return err
 	}
@@ -70,7 +72,7 @@ func Insert(world *World, entity EntityId, components ...IComponent) (resultErr
 			return err
 		}
 
-		newRow, err = newArchetype.components[componentId].insertRaw(world, rawComponent)
+		newRow, err = newArchetype.components[componentId].insertRaw(world, rawComponent, oldStorage.ticks[entityData.row])
 		if err != nil {
 			return err
 		}
@@ -99,8 +101,10 @@ func Insert(world *World, entity EntityId, components ...IComponent) (resultErr
 		}
 	}
 
+	addedComponentIds := componentIdsToAdd
 	for _, component := range requiredComponents {
 		componentId := ComponentIdOf(component, world)
+		addedComponentIds = append(addedComponentIds, componentId)
 		storage := newArchetype.components[componentId]
 		newRow, err = storage.insert(world, component)
 		if err != nil {
@@ -111,9 +115,10 @@ func Insert(world *World, entity EntityId, components ...IComponent) (resultErr
 
 	entityData.archetype = newArchetype
 	entityData.row = newRow
-	world.archetypeStorage.entityIdToArchetype[entity] = newArchetype
 	newArchetype.entities = append(newArchetype.entities, entity)
 
+	world.triggerObservers(observerEventAdd, entity, addedComponentIds)
+
 	return resultErr
 }
 
@@ -134,7 +139,7 @@ func InsertOrOverwrite(world *World, entity EntityId, components ...IComponent)
 		return nil
 	}
 
-	entityData, ok := world.entities[entity]
+	entityData, ok := world.getEntityData(entity)
 	if !ok {
 		return ErrEntityNotFound
 	}
@@ -151,9 +156,12 @@ func InsertOrOverwrite(world *World, entity EntityId, components ...IComponent)
 
 	componentIdsToAdd := make([]ComponentId, 0, len(componentIds))
 	componentsToAdd := make([]IComponent, 0, len(components))
+	overwrittenComponentIds := make([]ComponentId, 0, len(componentIds))
 	for i, componentId := range componentIds {
 		if oldArchetype.HasComponent(componentId) {
 			oldArchetype.components[componentId].set(components[i], entityData.row)
+			oldArchetype.components[componentId].markChanged(entityData.row, world.currentChangeTick())
+			overwrittenComponentIds = append(overwrittenComponentIds, componentId)
 		} else {
 			componentIdsToAdd = append(componentIdsToAdd, componentId)
 			componentsToAdd = append(componentsToAdd, components[i])
@@ -161,14 +169,17 @@ func InsertOrOverwrite(world *World, entity EntityId, components ...IComponent)
 	}
 
 	if len(componentIdsToAdd) == 0 {
+		world.triggerObservers(observerEventInsert, entity, overwrittenComponentIds)
 		return resultErr
 	}
 
 	// move archetype
-	newComponentIds := append(componentIdsToAdd, oldArchetype.componentIds...)
+	newComponentIds := make([]ComponentId, 0, len(componentIdsToAdd)+len(oldArchetype.componentIds))
+	newComponentIds = append(newComponentIds, componentIdsToAdd...)
+	newComponentIds = append(newComponentIds, oldArchetype.componentIds...)
 	requiredComponents := getAllRequiredComponents(&newComponentIds, componentsToAdd, world)
 
-	newArchetype, err := world.archetypeStorage.getArchetype(world, newComponentIds)
+	newArchetype, err := world.archetypeStorage.getArchetypeWithAddedComponents(world, oldArchetype, componentIdsToAdd, newComponentIds)
 	if err != nil {
 		return err
 	}
@@ -182,7 +193,7 @@ func InsertOrOverwrite(world *World, entity EntityId, components ...IComponent)
 			return err
 		}
 
-		newRow, err = newArchetype.components[componentId].insertRaw(world, rawComponent)
+		newRow, err = newArchetype.components[componentId].insertRaw(world, rawComponent, oldStorage.ticks[entityData.row])
 		if err != nil {
 			return err
 		}
@@ -211,8 +222,10 @@ func InsertOrOverwrite(world *World, entity EntityId, components ...IComponent)
 		}
 	}
 
+	addedComponentIds := componentIdsToAdd
 	for _, component := range requiredComponents {
 		componentId := ComponentIdOf(component, world)
+		addedComponentIds = append(addedComponentIds, componentId)
 		storage := newArchetype.components[componentId]
 		newRow, err = storage.insert(world, component)
 		if err != nil {
@@ -223,8 +236,10 @@ func InsertOrOverwrite(world *World, entity EntityId, components ...IComponent)
 
 	entityData.archetype = newArchetype
 	entityData.row = newRow
-	world.archetypeStorage.entityIdToArchetype[entity] = newArchetype
 	newArchetype.entities = append(newArchetype.entities, entity)
 
+	world.triggerObservers(observerEventInsert, entity, overwrittenComponentIds)
+	world.triggerObservers(observerEventAdd, entity, addedComponentIds)
+
 	return resultErr
 }
@@ -0,0 +1,145 @@
+// Generates the ecs code that only differs in the number of components, such as Query1 to Query16, Get1 to
+// Get16, Remove1 to Remove16 and CommandsRemove1 to CommandsRemove16.
+//
+// Run it from the ecs package with go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// maxComponents is the highest number of components that the generated functions and types support.
+const maxComponents = 16
+
+const header = "// Code generated by internal/generate. DO NOT EDIT.\n\n"
+
+// files maps the name of each generated file to the template that generates it.
+var files = map[string]string{
+	"query_gen.go":                  queryTemplate,
+	"query_result_gen.go":           queryResultTemplate,
+	"query_option_optional_gen.go":  optionalTemplate,
+	"query_option_read_only_gen.go": readOnlyTemplate,
+	"get_component_gen.go":          getTemplate,
+	"remove_component_gen.go":       removeTemplate,
+}
+
+// component holds the names that are used for one component in the generated code.
+type component struct {
+	Letter    string // e.g. A
+	Variable  string // e.g. a
+	Component string // e.g. ComponentA
+}
+
+// variant holds everything the templates need to generate code for N components.
+type variant struct {
+	N          int
+	Components []component
+}
+
+// TypeParams returns the type parameter names separated by commas, e.g. "A, B, C".
+func (v variant) TypeParams() string {
+	letters := make([]string, len(v.Components))
+	for i, c := range v.Components {
+		letters[i] = c.Letter
+	}
+	return strings.Join(letters, ", ")
+}
+
+// ComponentTypeParams returns the long type parameter names separated by commas, e.g. "ComponentA, ComponentB".
+func (v variant) ComponentTypeParams() string {
+	names := make([]string, len(v.Components))
+	for i, c := range v.Components {
+		names[i] = c.Component
+	}
+	return strings.Join(names, ", ")
+}
+
+// Nils returns as many nils as there are components, separated by commas.
+func (v variant) Nils() string {
+	return strings.Repeat("nil, ", v.N)
+}
+
+// OptionList returns a list of the option types with the given prefix for use in doc comments, e.g.
+// "[ReadOnly1], [ReadOnly2] (and so on)".
+func (v variant) OptionList(prefix string) string {
+	switch v.N {
+	case 1:
+		return fmt.Sprintf("[%s1]", prefix)
+	case 2:
+		return fmt.Sprintf("[%s1], [%s2]", prefix, prefix)
+	default:
+		return fmt.Sprintf("[%s1], [%s2] (and so on)", prefix, prefix)
+	}
+}
+
+func variants() []variant {
+	result := make([]variant, maxComponents)
+
+	for n := 1; n <= maxComponents; n++ {
+		components := make([]component, n)
+		for i := range n {
+			letter := string(rune('A' + i))
+			components[i] = component{
+				Letter:    letter,
+				Variable:  strings.ToLower(letter),
+				Component: "Component" + letter,
+			}
+		}
+		result[n-1] = variant{N: n, Components: components}
+	}
+
+	return result
+}
+
+// generate returns the formatted source of each generated file.
+func generate() (map[string][]byte, error) {
+	result := map[string][]byte{}
+	data := variants()
+
+	for fileName, text := range files {
+		tmpl, err := template.New(fileName).Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse template of %s: %w", fileName, err)
+		}
+
+		buffer := bytes.NewBufferString(header)
+		if err := tmpl.Execute(buffer, data); err != nil {
+			return nil, fmt.Errorf("failed to execute template of %s: %w", fileName, err)
+		}
+
+		source, err := format.Source(buffer.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("failed to format %s: %w", fileName, err)
+		}
+
+		result[fileName] = source
+	}
+
+	return result, nil
+}
+
+func main() {
+	outputDir := "."
+	if len(os.Args) > 1 {
+		outputDir = os.Args[1]
+	}
+
+	generated, err := generate()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	for fileName, source := range generated {
+		if err := os.WriteFile(filepath.Join(outputDir, fileName), source, 0o644); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
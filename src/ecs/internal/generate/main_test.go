@@ -0,0 +1,23 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneratedFilesAreUpToDate(t *testing.T) {
+	assert := assert.New(t)
+
+	generated, err := generate()
+	assert.NoError(err)
+	assert.Len(generated, len(files))
+
+	for fileName, source := range generated {
+		existing, err := os.ReadFile(filepath.Join("..", "..", fileName))
+		assert.NoError(err)
+		assert.Equal(string(source), string(existing), "%s is outdated, run go generate in the ecs package", fileName)
+	}
+}
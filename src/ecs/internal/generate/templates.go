@@ -0,0 +1,268 @@
+package main
+
+const queryTemplate = `package ecs
+{{range .}}
+// Query{{.N}} queries {{.N}} component{{if gt .N 1}}s{{end}}.
+//
+// Prepare must be called once before calling Execute.
+//
+// The following query options are available:
+//   - use [NoReadOnly], [AllReadOnly], {{.OptionList "ReadOnly"}} to specify if components are read-only.
+//     Marking components as read-only allows systems with queries as system-params to be run in parallel with
+//     other systems.
+//   - use [NoOptional], {{.OptionList "Optional"}} to mark components as optional. When a component is
+//     optional, entities do not have to have that component in order for it to return a result, as long as it
+//     has the other (not-optional) components.
+//   - use [NoFilter] to not use any filters
+//   - use [With] to make the results only include entities that has a specific component.
+//   - use [Without] to make the results only include entities that do not have a specific component.
+//   - use [Added] and [Changed] to make the results only include entities of which a specific component was
+//     added or changed since the query was last executed.
+//   - use [And] and [Or] to combine filters.
+type Query{{.N}}[{{.ComponentTypeParams}} IComponent, _ QueryOption] struct {
+	queryOptions
+	results Query{{.N}}Result[{{.ComponentTypeParams}}]
+{{- range .Components}}
+	componentId{{.Letter}} ComponentId
+{{- end}}
+}
+{{end}}
+{{- range .}}
+func (q *Query{{.N}}[{{.ComponentTypeParams}}, QueryOptions]) Exec(world *World) (err error) {
+	q.ClearResults()
+
+	archetypes := q.matchingArchetypes(world)
+	lastRun, thisRun := q.advanceChangeTick(world)
+	usesTicks := q.options.usesTicks()
+
+	for _, archetype := range archetypes {
+{{- range .Components}}
+		fetch{{.Letter}} := archetype.HasComponent(q.componentId{{.Letter}})
+{{- end}}
+
+		for _, entity := range archetype.entities {
+			entityData := &world.entities[entity.index]
+			if usesTicks && q.options.isEntityFilteredOutByTicks(entityData, lastRun) {
+				continue
+			}
+{{range .Components}}
+			var {{.Variable}} *{{.Component}}
+			if fetch{{.Letter}} {
+				{{.Variable}}, err = fetchComponentForQueryResult[{{.Component}}](q.componentId{{.Letter}}, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+{{end}}
+{{- range .Components}}
+			q.results.components{{.Letter}} = append(q.results.components{{.Letter}}, {{.Variable}})
+{{- end}}
+			q.results.entityIds = append(q.results.entityIds, entity)
+		}
+	}
+
+	return nil
+}
+{{end}}
+{{- range .}}
+func (q *Query{{.N}}[{{.TypeParams}}, QueryOptions]) Prepare(world *World) (err error) {
+	q.options, err = toCombinedQueryOptions[QueryOptions](world)
+	q.archetypeCache = archetypeCache{}
+{{- range .Components}}
+	q.componentId{{.Letter}} = ComponentIdFor[{{.Letter}}](world)
+{{- end}}
+	q.components = []ComponentId{
+{{- range .Components}}
+		q.componentId{{.Letter}},
+{{- end}}
+	}
+	q.options.optimize(q.components)
+	return err
+}
+{{end}}
+{{- range .}}
+func (q *Query{{.N}}[{{.ComponentTypeParams}}, QueryOptions]) Result() *Query{{.N}}Result[{{.ComponentTypeParams}}] {
+	return &q.results
+}
+{{end}}
+{{- range .}}
+func (q *Query{{.N}}[{{.ComponentTypeParams}}, QueryOptions]) ClearResults() {
+	q.results.Clear()
+}
+{{end}}`
+
+const queryResultTemplate = `package ecs
+{{range .}}
+type Query{{.N}}Result[{{.TypeParams}} IComponent] struct {
+{{- range .Components}}
+	components{{.Letter}} []*{{.Letter}}
+{{- end}}
+	entityIds []EntityId
+}
+{{end}}
+{{- range .}}
+func (q *Query{{.N}}Result[{{.TypeParams}}]) Clear() {
+{{- range .Components}}
+	q.components{{.Letter}} = []*{{.Letter}}{}
+{{- end}}
+	q.entityIds = []EntityId{}
+}
+{{end}}
+{{- range .}}
+func (q *Query{{.N}}Result[{{.TypeParams}}]) NumberOfResult() uint {
+	return uint(len(q.entityIds))
+}
+{{end}}
+{{- range .}}{{if le .N 2}}
+// Range lets you range over the query result
+//
+// for component := range queryResult.Range() { ... }
+func (q *Query{{.N}}Result[{{.TypeParams}}]) Range() func(yield func({{range $i, $c := .Components}}{{if $i}}, {{end}}*{{$c.Letter}}{{end}}) bool) {
+	return func(yield func({{range $i, $c := .Components}}{{if $i}}, {{end}}*{{$c.Letter}}{{end}}) bool) {
+		for i := range q.entityIds {
+			if !yield({{range $i, $c := .Components}}{{if $i}}, {{end}}q.components{{$c.Letter}}[i]{{end}}) {
+				return
+			}
+		}
+	}
+}
+{{end}}{{end}}
+{{- range .}}
+// Iter executes function f on each entity that the query returned, until f returns an error.
+// If any of the calls to f returned an error, this function returns that error.
+func (q *Query{{.N}}Result[{{.TypeParams}}]) Iter(f func(entityId EntityId{{range .Components}}, {{.Variable}} *{{.Letter}}{{end}}) error) error {
+	for i := range q.entityIds {
+		if err := f(q.entityIds[i]{{range .Components}}, q.components{{.Letter}}[i]{{end}}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+{{end}}`
+
+const optionalTemplate = `package ecs
+{{range .}}
+{{- if eq .N 1}}
+// Entities will be in query result even if A is not present. If A is not present,
+// it will be nil in the query result.
+{{- else}}
+// Entities will be in query result even if any of these are not present. Components that are
+// not present will be nil in the query result.
+{{- end}}
+type Optional{{.N}}[{{.TypeParams}} IComponent] struct{}
+{{end}}
+{{- range .}}
+func (o Optional{{.N}}[{{.TypeParams}}]) getOptionalComponentIds(world *World) []ComponentId {
+	return []ComponentId{
+{{- range .Components}}
+		ComponentIdFor[{{.Letter}}](world),
+{{- end}}
+	}
+}
+{{end}}
+{{- range .}}
+func (optional Optional{{.N}}[{{.TypeParams}}]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, Optional{{.N}}[{{.TypeParams}}], NoReadOnly, NotLazy, DefaultWorld]](world)
+}
+{{- end}}
+`
+
+const readOnlyTemplate = `package ecs
+{{range .}}
+{{- if eq .N 1}}
+// Component in the query results is read-only.
+// The component in the query results will be a temporary copy.
+{{- else}}
+// Components in the query results are read-only.
+// The components in the query results will be temporary copies.
+{{- end}}
+//
+// This allows parallelization of systems that use this query as a system parameter.
+type ReadOnly{{.N}}[{{.TypeParams}} IComponent] struct{}
+{{end}}
+{{- range .}}
+func (o ReadOnly{{.N}}[{{.TypeParams}}]) getReadonlyComponentIds(world *World) (readOnlyComponentIds []ComponentId, isAllReadyOnly bool) {
+	return []ComponentId{
+{{- range .Components}}
+		ComponentIdFor[{{.Letter}}](world),
+{{- end}}
+	}, false
+}
+{{end}}
+{{- range .}}
+func (readOnly ReadOnly{{.N}}[{{.TypeParams}}]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, NoOptional, ReadOnly{{.N}}[{{.TypeParams}}], NotLazy, DefaultWorld]](world)
+}
+{{- end}}
+`
+
+const getTemplate = `package ecs
+{{range .}}
+{{- if eq .N 1}}
+// Get1 returns the component that belongs to the given entity.
+//
+// Can return the following errors:
+//   - ErrEntityNotFound error if the entity is not found.
+//   - ErrComponentNotFound error if the entity does not have the component.
+//
+// WARNING: Do not store the component pointer
+{{- else}}
+// Get{{.N}} returns the components that belong to the given entity.
+//
+// Can return the following errors:
+//   - ErrEntityNotFound error if the entity is not found.
+//   - ErrComponentNotFound error if the entity does not have any of the components.
+//
+// Returns the same component pointer multiple times if multiple component of the same type are given.
+//
+// WARNING: Do not store any of the component pointers
+{{- end}}
+func Get{{.N}}[{{.TypeParams}} IComponent](world *World, entity EntityId) ({{range .Components}}{{.Variable}} *{{.Letter}}, {{end}}err error) {
+	entityData, ok := world.getEntityData(entity)
+	if !ok {
+		return {{.Nils}}ErrEntityNotFound
+	}
+{{$nils := .Nils}}
+{{- range $i, $c := .Components}}
+	{{if $i}}} else if{{else}}if{{end}} err = setComponentFromEntry(world, entityData, &{{$c.Variable}}); err != nil {
+		return {{$nils}}err
+{{- end}}
+	}
+
+	return {{range .Components}}{{.Variable}}, {{end}}nil
+}
+{{end}}`
+
+const removeTemplate = `package ecs
+{{range .}}
+{{- if eq .N 1}}
+// Remove1 removes the given component from entity.
+{{- else}}
+// Remove{{.N}} removes the given components from entity.
+{{- end}}
+//
+// Can return the following errors:
+//   - ErrEntityNotFound error if the entity does not exist in world.
+//   - ErrComponentNotFound error if the component is not present in the entity.
+func Remove{{.N}}[{{.TypeParams}} IComponent](world *World, entity EntityId) error {
+	return removeComponents(world, entity, []ComponentId{
+{{- range .Components}}
+		ComponentIdFor[{{.Letter}}](world),
+{{- end}}
+	})
+}
+
+{{- if eq .N 1}}
+
+// CommandsRemove1 records removing the given component from entity. See [Remove1].
+{{- else}}
+
+// CommandsRemove{{.N}} records removing the given components from entity. See [Remove{{.N}}].
+{{- end}}
+func CommandsRemove{{.N}}[{{.TypeParams}} IComponent](commands *Commands, entity EntityId) {
+	commands.add(func(world *World) error {
+		return Remove{{.N}}[{{.TypeParams}}](world, entity)
+	})
+}
+{{end}}`
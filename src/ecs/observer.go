@@ -0,0 +1,119 @@
+package ecs
+
+import "unsafe"
+
+type observerEvent int
+
+const (
+	observerEventAdd     observerEvent = iota // component got added by Spawn, Insert or InsertOrOverwrite
+	observerEventInsert                       // existing component got overwritten by InsertOrOverwrite
+	observerEventRemove                       // component got removed by Remove1, Remove2 (and so on)
+	observerEventDespawn                      // component got destroyed by Delete
+)
+
+type observer func(entity EntityId, component unsafe.Pointer)
+
+type observerKey struct {
+	event       observerEvent
+	componentId uint
+}
+
+// observerStorage holds the callbacks that are registered with OnAdd, OnInsert, OnRemove and OnDespawn.
+type observerStorage struct {
+	observers map[observerKey][]observer
+	events    map[observerEvent]uint // number of observers per event, to quickly skip events without observers
+}
+
+func newObserverStorage() observerStorage {
+	return observerStorage{
+		observers: map[observerKey][]observer{},
+		events:    map[observerEvent]uint{},
+	}
+}
+
+func (s *observerStorage) add(event observerEvent, componentId ComponentId, callback observer) {
+	key := observerKey{event: event, componentId: componentId.id}
+	s.observers[key] = append(s.observers[key], callback)
+	s.events[event]++
+}
+
+// OnAdd registers a callback that is called when a component of type C is added to an entity by Spawn,
+// Insert or InsertOrOverwrite. This includes components that are added because they are required by
+// another component.
+//
+// The callback is called after the component is added.
+//
+// WARNING: Do not store the component pointer
+func OnAdd[C IComponent](world *World, callback func(entity EntityId, component *C)) {
+	addObserver(world, observerEventAdd, callback)
+}
+
+// OnInsert registers a callback that is called when an existing component of type C is overwritten by
+// InsertOrOverwrite.
+//
+// The callback is called after the component is overwritten.
+//
+// WARNING: Do not store the component pointer
+func OnInsert[C IComponent](world *World, callback func(entity EntityId, component *C)) {
+	addObserver(world, observerEventInsert, callback)
+}
+
+// OnRemove registers a callback that is called when a component of type C is removed from an entity by
+// Remove1, Remove2 (and so on).
+//
+// The callback is called before the component is removed.
+//
+// WARNING: Do not store the component pointer
+func OnRemove[C IComponent](world *World, callback func(entity EntityId, component *C)) {
+	addObserver(world, observerEventRemove, callback)
+}
+
+// OnDespawn registers a callback that is called when an entity with a component of type C is deleted by
+// Delete.
+//
+// The callback is called before the entity is deleted.
+//
+// WARNING: Do not store the component pointer
+func OnDespawn[C IComponent](world *World, callback func(entity EntityId, component *C)) {
+	addObserver(world, observerEventDespawn, callback)
+}
+
+func addObserver[C IComponent](world *World, event observerEvent, callback func(entity EntityId, component *C)) {
+	world.observers.add(event, ComponentIdFor[C](world), func(entity EntityId, component unsafe.Pointer) {
+		callback(entity, (*C)(component))
+	})
+}
+
+// triggerObservers calls the observers of event for each of the given components of entity.
+//
+// Observers are allowed to make structural changes to the world, so the entity data and component
+// pointer are retrieved again before calling each observer. Components that the entity no longer has
+// are skipped.
+func (world *World) triggerObservers(event observerEvent, entity EntityId, componentIds []ComponentId) {
+	if world.observers.events[event] == 0 {
+		return
+	}
+
+	for _, componentId := range componentIds {
+		callbacks := world.observers.observers[observerKey{event: event, componentId: componentId.id}]
+
+		for _, callback := range callbacks {
+			entityData, ok := world.getEntityData(entity)
+			if !ok {
+				return
+			}
+
+			storage, ok := entityData.archetype.components[componentId]
+			if !ok {
+				break
+			}
+
+			component, err := storage.getComponentPointer(entityData.row)
+			if err != nil {
+				break
+			}
+
+			callback(entity, component)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package ecs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type componentThatRequiresEmptyComponentA struct{ Component }
+
+func (componentThatRequiresEmptyComponentA) RequiredComponents() []IComponent {
+	return []IComponent{&emptyComponentA{}}
+}
+
+func TestOnAdd(t *testing.T) {
+	type componentA struct {
+		Component
+		value int
+	}
+	type componentB struct{ Component }
+
+	t.Run("is called when spawning an entity with the component", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		var observedEntity EntityId
+		observedValue := 0
+		OnAdd(&world, func(entity EntityId, component *componentA) {
+			observedEntity = entity
+			observedValue = component.value
+		})
+
+		_, err := Spawn(&world, &componentB{})
+		assert.NoError(err)
+		assert.Equal(0, observedValue)
+
+		entity, err := Spawn(&world, &componentB{}, &componentA{value: 10})
+		assert.NoError(err)
+		assert.Equal(entity, observedEntity)
+		assert.Equal(10, observedValue)
+	})
+
+	t.Run("is called when inserting the component", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		calls := 0
+		OnAdd(&world, func(entity EntityId, component *componentA) {
+			calls++
+		})
+
+		entity, err := Spawn(&world, &componentB{})
+		assert.NoError(err)
+		err = Insert(&world, entity, &componentA{})
+		assert.NoError(err)
+		assert.Equal(1, calls)
+
+		// component is already present, so it does not get added
+		err = Insert(&world, entity, &componentA{})
+		assert.ErrorIs(err, ErrComponentAlreadyPresent)
+		err = InsertOrOverwrite(&world, entity, &componentA{})
+		assert.NoError(err)
+		assert.Equal(1, calls)
+	})
+
+	t.Run("component pointer can be used to mutate the component", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		OnAdd(&world, func(entity EntityId, component *componentA) {
+			component.value = 5
+		})
+
+		entity, err := Spawn(&world, &componentB{})
+		assert.NoError(err)
+		err = Insert(&world, entity, &componentA{value: 1})
+		assert.NoError(err)
+
+		a, err := Get1[componentA](&world, entity)
+		assert.NoError(err)
+		assert.Equal(5, a.value)
+	})
+
+	t.Run("is called for required components", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		calls := 0
+		OnAdd(&world, func(entity EntityId, component *emptyComponentA) {
+			calls++
+		})
+
+		_, err := Spawn(&world, &componentThatRequiresEmptyComponentA{})
+		assert.NoError(err)
+		assert.Equal(1, calls)
+
+		entity, err := Spawn(&world)
+		assert.NoError(err)
+		err = Insert(&world, entity, &componentThatRequiresEmptyComponentA{})
+		assert.NoError(err)
+		assert.Equal(2, calls)
+	})
+}
+
+func TestOnInsert(t *testing.T) {
+	type componentA struct {
+		Component
+		value int
+	}
+
+	t.Run("is called when overwriting the component", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		observedValue := 0
+		OnInsert(&world, func(entity EntityId, component *componentA) {
+			observedValue = component.value
+		})
+
+		entity, err := Spawn(&world, &componentA{value: 1})
+		assert.NoError(err)
+		assert.Equal(0, observedValue)
+
+		err = InsertOrOverwrite(&world, entity, &componentA{value: 2})
+		assert.NoError(err)
+		assert.Equal(2, observedValue)
+
+		err = InsertOrOverwrite(&world, entity, &componentA{value: 3}, &emptyComponentA{})
+		assert.NoError(err)
+		assert.Equal(3, observedValue)
+	})
+}
+
+func TestOnRemove(t *testing.T) {
+	type componentA struct {
+		Component
+		value int
+	}
+	type componentB struct{ Component }
+
+	t.Run("is called before removing the component", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		var observedEntity EntityId
+		observedValue := 0
+		OnRemove(&world, func(entity EntityId, component *componentA) {
+			observedEntity = entity
+			observedValue = component.value
+		})
+
+		entity, err := Spawn(&world, &componentA{value: 7}, &componentB{})
+		assert.NoError(err)
+
+		err = Remove1[componentB](&world, entity)
+		assert.NoError(err)
+		assert.Equal(0, observedValue)
+
+		err = Remove1[componentA](&world, entity)
+		assert.NoError(err)
+		assert.Equal(entity, observedEntity)
+		assert.Equal(7, observedValue)
+	})
+
+	t.Run("observer can make structural changes to the entity", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		OnRemove(&world, func(entity EntityId, component *componentA) {
+			err := Remove1[componentB](&world, entity)
+			assert.NoError(err)
+		})
+
+		entity, err := Spawn(&world, &componentA{value: 7}, &componentB{}, &emptyComponentA{})
+		assert.NoError(err)
+
+		err = Remove2[componentA, componentB](&world, entity)
+		assert.NoError(err)
+
+		hasA, err := HasComponent[componentA](&world, entity)
+		assert.NoError(err)
+		assert.False(hasA)
+		hasB, err := HasComponent[componentB](&world, entity)
+		assert.NoError(err)
+		assert.False(hasB)
+		hasEmptyA, err := HasComponent[emptyComponentA](&world, entity)
+		assert.NoError(err)
+		assert.True(hasEmptyA)
+	})
+}
+
+func TestOnDespawn(t *testing.T) {
+	type componentA struct {
+		Component
+		value int
+	}
+
+	t.Run("is called before deleting the entity", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		var observedEntity EntityId
+		observedValue := 0
+		OnDespawn(&world, func(entity EntityId, component *componentA) {
+			observedEntity = entity
+			observedValue = component.value
+
+			assert.True(world.IsAlive(entity))
+		})
+
+		entity, err := Spawn(&world, &componentA{value: 3})
+		assert.NoError(err)
+		err = Delete(&world, entity)
+		assert.NoError(err)
+
+		assert.Equal(entity, observedEntity)
+		assert.Equal(3, observedValue)
+		assert.False(world.IsAlive(entity))
+	})
+
+	t.Run("observer can spawn entities", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		OnDespawn(&world, func(entity EntityId, component *componentA) {
+			for range 10 {
+				_, err := Spawn(&world, &componentA{})
+				assert.NoError(err)
+			}
+		})
+
+		entity, err := Spawn(&world, &componentA{value: 3})
+		assert.NoError(err)
+		err = Delete(&world, entity)
+		assert.NoError(err)
+
+		assert.Equal(10, world.CountEntities())
+	})
+}
@@ -7,6 +7,9 @@ import (
 	"github.com/lucdrenth/murphecs/src/utils"
 )
 
+// Query1 to Query16 and their results, options, Get and Remove functions are generated.
+//go:generate go run ./internal/generate
+
 type Query interface {
 	Exec(world *World) error
 
@@ -34,18 +37,79 @@ type Query interface {
 	// world should be used, in which case it defaults to the world of the SubApp it is used in.
 	TargetWorld() *WorldId
 
+	// ComponentAccess returns the components that the query fetches, split in to components that are read-only
+	// and components that can be mutated. Prepare must be called before calling this method.
+	ComponentAccess() (readOnly []ComponentId, mutable []ComponentId)
+
 	getOptions() *CombinedQueryOptions
 }
 
 type queryOptions struct {
-	options    CombinedQueryOptions
-	components []ComponentId
+	options        CombinedQueryOptions
+	components     []ComponentId
+	archetypeCache archetypeCache
+	lastRunTick    uint // change tick of the last time that the query was executed
+}
+
+// archetypeCache keeps track of the archetypes that match a query, so that Exec does not have to check
+// every archetype of the world on every call.
+type archetypeCache struct {
+	world                     *World
+	numberOfCheckedArchetypes int          // the archetypes of world up until this index have been checked
+	archetypes                []*Archetype // the checked archetypes that match the query
 }
 
 func (o *queryOptions) getOptions() *CombinedQueryOptions {
+	// The options are returned so that they can be altered, which could change which archetypes match.
+	o.archetypeCache = archetypeCache{}
 	return &o.options
 }
 
+// matchingArchetypes returns the archetypes of world that match the query components and filters.
+//
+// Archetypes are never removed from a world, so only the archetypes that were created since the last call
+// need to be checked.
+func (o *queryOptions) matchingArchetypes(world *World) []*Archetype {
+	if o.archetypeCache.world != world {
+		o.archetypeCache = archetypeCache{world: world}
+		o.lastRunTick = 0 // change ticks are not comparable between worlds
+	}
+
+	archetypes := world.archetypeStorage.archetypes
+	for _, archetype := range archetypes[o.archetypeCache.numberOfCheckedArchetypes:] {
+		if o.isArchetypeMatch(archetype) {
+			o.archetypeCache.archetypes = append(o.archetypeCache.archetypes, archetype)
+		}
+	}
+	o.archetypeCache.numberOfCheckedArchetypes = len(archetypes)
+
+	return o.archetypeCache.archetypes
+}
+
+// advanceChangeTick returns the change tick of the last time that the query was executed, and the change tick of
+// the current execution. Components that are marked as changed during the current execution get thisRun as tick,
+// so that the query does not see its own changes the next time it is executed.
+func (o *queryOptions) advanceChangeTick(world *World) (lastRun uint, thisRun uint) {
+	lastRun = o.lastRunTick
+	thisRun = world.advanceChangeTick()
+	o.lastRunTick = thisRun
+	return lastRun, thisRun
+}
+
+func (o *queryOptions) isArchetypeMatch(archetype *Archetype) bool {
+	if o.options.isArchetypeFilteredOut(archetype) {
+		return false
+	}
+
+	for _, componentId := range o.components {
+		if _, skip := shouldHandleQueryComponent(componentId, archetype, &o.options); skip {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (o *queryOptions) IsLazy() bool {
 	return o.options.isLazy
 }
@@ -54,6 +118,18 @@ func (o *queryOptions) TargetWorld() *WorldId {
 	return o.options.TargetWorld
 }
 
+func (o *queryOptions) ComponentAccess() (readOnly []ComponentId, mutable []ComponentId) {
+	for _, componentId := range o.components {
+		if o.options.ReadOnlyComponents.IsAllReadOnly || slices.Contains(o.options.ReadOnlyComponents.ComponentIds, componentId) {
+			readOnly = append(readOnly, componentId)
+		} else {
+			mutable = append(mutable, componentId)
+		}
+	}
+
+	return readOnly, mutable
+}
+
 func (o *queryOptions) Validate() error {
 	return o.options.validateOptions(o.components)
 }
@@ -66,303 +142,31 @@ func (o *queryOptions) Validate() error {
 //   - use [NoFilter] to not use any filters
 //   - use [With] to make the results only include entities that has a specific component.
 //   - use [Without] to make the results only include entities that do not have a specific component.
+//   - use [Added] and [Changed] to make the results only include entities of which a specific component was
+//     added or changed since the query was last executed.
 //   - use [And] and [Or] to combine filters.
 type Query0[_ QueryOption] struct {
 	queryOptions
 	results Query0Result
 }
 
-// Query1 queries 1 component.
-//
-// Prepare must be called once before calling Execute.
-//
-// The following query options are available:
-//   - use [NoReadOnly], [AllReadOnly], [ReadOnly1] to specify if components are read-only. Marking components as
-//     read-only allows systems with queries as system-params to be run in parallel with other systems.
-//   - use [NoOptional], [Optional1] to mark component as optional. When a component is optional, entities do not
-//     have to have that component in order for it to return a result, as long as it has the other (not-optional)
-//     components.
-//   - use [NoFilter] to not use any filters
-//   - use [With] to make the results only include entities that has a specific component.
-//   - use [Without] to make the results only include entities that do not have a specific component.
-//   - use [And] and [Or] to combine filters.
-type Query1[ComponentA IComponent, _ QueryOption] struct {
-	queryOptions
-	results      Query1Result[ComponentA]
-	componentIdA ComponentId
-}
-
-// Query2 queries 2 components.
-//
-// Prepare must be called once before calling Execute.
-//
-// The following query options are available:
-//   - use [NoReadOnly], [AllReadOnly], [ReadOnly1], [ReadOnly2] to specify if components are read-only. Marking
-//     components as read-only allows systems with queries as system-params to be run in parallel with other systems.
-//   - use [NoOptional], [Optional1], [Optional2] to mark components as optional. When a component is optional, entities
-//     do not have to have that component in order for it to return a result, as long as it has the other (not-optional)
-//     components.
-//   - use [NoFilter] to not use any filters
-//   - use [With] to make the results only include entities that has a specific component.
-//   - use [Without] to make the results only include entities that do not have a specific component.
-//   - use [And] and [Or] to combine filters.
-type Query2[ComponentA, ComponentB IComponent, _ QueryOption] struct {
-	queryOptions
-	results      Query2Result[ComponentA, ComponentB]
-	componentIdA ComponentId
-	componentIdB ComponentId
-}
-
-// Query3 queries 3 components.
-//
-// Prepare must be called once before calling Execute.
-//
-// The following query options are available:
-//   - use [NoReadOnly], [AllReadOnly], [ReadOnly1], [ReadOnly2] (and so on) to specify if components
-//     are read-only. Marking components as read-only allows systems with queries as system-params to be run in
-//     parallel with other systems.
-//   - use [NoOptional], [Optional1], [Optional2] (and so on) to mark components as optional. When a
-//     component is optional, entities do not have to have that component in order for it to return a result,
-//     as long as it has the other (not-optional) components.
-//   - use [NoFilter] to not use any filters
-//   - use [With] to make the results only include entities that has a specific component.
-//   - use [Without] to make the results only include entities that do not have a specific component.
-//   - use [And] and [Or] to combine filters.
-type Query3[ComponentA, ComponentB, ComponentC IComponent, _ QueryOption] struct {
-	queryOptions
-	results      Query3Result[ComponentA, ComponentB, ComponentC]
-	componentIdA ComponentId
-	componentIdB ComponentId
-	componentIdC ComponentId
-}
-
-// Query4 queries 4 components.
-//
-// Prepare must be called once before calling Execute.
-//
-// The following query options are available:
-//   - use [NoReadOnly], [AllReadOnly], [ReadOnly1], [ReadOnly2] (and so on) to specify if components
-//     are read-only. Marking components as read-only allows systems with queries as system-params to be run in
-//     parallel with other systems.
-//   - use [NoOptional], [Optional1], [Optional2] (and so on) to mark components as optional. When a
-//     component is optional, entities do not have to have that component in order for it to return a result,
-//     as long as it has the other (not-optional) components.
-//   - use [NoFilter] to not use any filters
-//   - use [With] to make the results only include entities that has a specific component.
-//   - use [Without] to make the results only include entities that do not have a specific component.
-//   - use [And] and [Or] to combine filters.
-type Query4[ComponentA, ComponentB, ComponentC, ComponentD IComponent, _ QueryOption] struct {
-	queryOptions
-	results      Query4Result[ComponentA, ComponentB, ComponentC, ComponentD]
-	componentIdA ComponentId
-	componentIdB ComponentId
-	componentIdC ComponentId
-	componentIdD ComponentId
-}
-
 func (q *Query0[QueryOptions]) Exec(world *World) error {
 	q.ClearResults()
 
-	for _, archetype := range world.archetypeStorage.componentsHashToArchetype {
-		if q.options.isArchetypeFilteredOut(archetype) {
-			continue
-		}
-
-		q.results.entityIds = append(q.results.entityIds, archetype.entities...)
-	}
-
-	return nil
-}
-
-func (q *Query1[ComponentA, QueryOptions]) Exec(world *World) (err error) {
-	q.ClearResults()
-
-	for _, archetype := range world.archetypeStorage.componentsHashToArchetype {
-		if q.options.isArchetypeFilteredOut(archetype) {
-			continue
-		}
-
-		fetchA, skip := shouldHandleQueryComponent(q.componentIdA, archetype, &q.queryOptions.options)
-		if skip {
-			continue
-		}
-
-		for _, entity := range archetype.entities {
-			var a *ComponentA
-			if fetchA {
-				a, err = fetchComponentForQueryResult[ComponentA](q.componentIdA, world.entities[entity].row, archetype, &q.options)
-				if err != nil {
-					return err
-				}
-			}
-
-			q.results.componentsA = append(q.results.componentsA, a)
-			q.results.entityIds = append(q.results.entityIds, entity)
-		}
-	}
-
-	return nil
-}
-func (q *Query2[ComponentA, ComponentB, QueryOptions]) Exec(world *World) (err error) {
-	q.ClearResults()
-
-	for _, archetype := range world.archetypeStorage.componentsHashToArchetype {
-		if q.options.isArchetypeFilteredOut(archetype) {
-			continue
-		}
-
-		fetchA, skip := shouldHandleQueryComponent(q.componentIdA, archetype, &q.queryOptions.options)
-		if skip {
-			continue
-		}
-		fetchB, skip := shouldHandleQueryComponent(q.componentIdB, archetype, &q.queryOptions.options)
-		if skip {
-			continue
-		}
-
-		for _, entity := range archetype.entities {
-			var a *ComponentA
-			if fetchA {
-				a, err = fetchComponentForQueryResult[ComponentA](q.componentIdA, world.entities[entity].row, archetype, &q.options)
-				if err != nil {
-					return err
-				}
-			}
-
-			var b *ComponentB
-			if fetchB {
-				b, err = fetchComponentForQueryResult[ComponentB](q.componentIdB, world.entities[entity].row, archetype, &q.options)
-				if err != nil {
-					return err
-				}
-			}
-
-			q.results.componentsA = append(q.results.componentsA, a)
-			q.results.componentsB = append(q.results.componentsB, b)
-			q.results.entityIds = append(q.results.entityIds, entity)
-		}
-	}
-
-	return nil
-}
-func (q *Query3[ComponentA, ComponentB, ComponentC, QueryOptions]) Exec(world *World) (err error) {
-	q.ClearResults()
-
-	for _, archetype := range world.archetypeStorage.componentsHashToArchetype {
-		if q.options.isArchetypeFilteredOut(archetype) {
-			continue
-		}
-
-		fetchA, skip := shouldHandleQueryComponent(q.componentIdA, archetype, &q.queryOptions.options)
-		if skip {
-			continue
-		}
-		fetchB, skip := shouldHandleQueryComponent(q.componentIdB, archetype, &q.queryOptions.options)
-		if skip {
-			continue
-		}
-		fetchC, skip := shouldHandleQueryComponent(q.componentIdC, archetype, &q.queryOptions.options)
-		if skip {
-			continue
-		}
-
-		for _, entity := range archetype.entities {
-			var a *ComponentA
-			if fetchA {
-				a, err = fetchComponentForQueryResult[ComponentA](q.componentIdA, world.entities[entity].row, archetype, &q.options)
-				if err != nil {
-					return err
-				}
-			}
-
-			var b *ComponentB
-			if fetchB {
-				b, err = fetchComponentForQueryResult[ComponentB](q.componentIdB, world.entities[entity].row, archetype, &q.options)
-				if err != nil {
-					return err
-				}
-			}
-
-			var c *ComponentC
-			if fetchC {
-				c, err = fetchComponentForQueryResult[ComponentC](q.componentIdC, world.entities[entity].row, archetype, &q.options)
-				if err != nil {
-					return err
-				}
-			}
+	archetypes := q.matchingArchetypes(world)
+	lastRun, _ := q.advanceChangeTick(world)
+	usesTicks := q.options.usesTicks()
 
-			q.results.componentsA = append(q.results.componentsA, a)
-			q.results.componentsB = append(q.results.componentsB, b)
-			q.results.componentsC = append(q.results.componentsC, c)
-			q.results.entityIds = append(q.results.entityIds, entity)
-		}
-	}
-
-	return nil
-}
-func (q *Query4[ComponentA, ComponentB, ComponentC, ComponentD, QueryOptions]) Exec(world *World) (err error) {
-	q.ClearResults()
-
-	for _, archetype := range world.archetypeStorage.componentsHashToArchetype {
-		if q.options.isArchetypeFilteredOut(archetype) {
-			continue
-		}
-
-		fetchA, skip := shouldHandleQueryComponent(q.componentIdA, archetype, &q.queryOptions.options)
-		if skip {
-			continue
-		}
-		fetchB, skip := shouldHandleQueryComponent(q.componentIdB, archetype, &q.queryOptions.options)
-		if skip {
-			continue
-		}
-		fetchC, skip := shouldHandleQueryComponent(q.componentIdC, archetype, &q.queryOptions.options)
-		if skip {
-			continue
-		}
-		fetchD, skip := shouldHandleQueryComponent(q.componentIdD, archetype, &q.queryOptions.options)
-		if skip {
+	for _, archetype := range archetypes {
+		if !usesTicks {
+			q.results.entityIds = append(q.results.entityIds, archetype.entities...)
 			continue
 		}
 
 		for _, entity := range archetype.entities {
-			var a *ComponentA
-			if fetchA {
-				a, err = fetchComponentForQueryResult[ComponentA](q.componentIdA, world.entities[entity].row, archetype, &q.options)
-				if err != nil {
-					return err
-				}
-			}
-
-			var b *ComponentB
-			if fetchB {
-				b, err = fetchComponentForQueryResult[ComponentB](q.componentIdB, world.entities[entity].row, archetype, &q.options)
-				if err != nil {
-					return err
-				}
+			if !q.options.isEntityFilteredOutByTicks(&world.entities[entity.index], lastRun) {
+				q.results.entityIds = append(q.results.entityIds, entity)
 			}
-
-			var c *ComponentC
-			if fetchC {
-				c, err = fetchComponentForQueryResult[ComponentC](q.componentIdC, world.entities[entity].row, archetype, &q.options)
-				if err != nil {
-					return err
-				}
-			}
-
-			var d *ComponentD
-			if fetchD {
-				d, err = fetchComponentForQueryResult[ComponentD](q.componentIdD, world.entities[entity].row, archetype, &q.options)
-				if err != nil {
-					return err
-				}
-			}
-
-			q.results.componentsA = append(q.results.componentsA, a)
-			q.results.componentsB = append(q.results.componentsB, b)
-			q.results.componentsC = append(q.results.componentsC, c)
-			q.results.componentsD = append(q.results.componentsD, d)
-			q.results.entityIds = append(q.results.entityIds, entity)
 		}
 	}
 
@@ -371,90 +175,19 @@ func (q *Query4[ComponentA, ComponentB, ComponentC, ComponentD, QueryOptions]) E
 
 func (q *Query0[QueryOptions]) Prepare(world *World) (err error) {
 	q.options, err = toCombinedQueryOptions[QueryOptions](world)
+	q.archetypeCache = archetypeCache{}
 	q.components = []ComponentId{}
 	q.options.optimize(q.components)
 	return err
 }
-func (q *Query1[A, QueryOptions]) Prepare(world *World) (err error) {
-	q.options, err = toCombinedQueryOptions[QueryOptions](world)
-	q.componentIdA = ComponentIdFor[A](world)
-	q.components = []ComponentId{
-		q.componentIdA,
-	}
-	q.options.optimize(q.components)
-	return err
-}
-func (q *Query2[A, B, QueryOptions]) Prepare(world *World) (err error) {
-	q.options, err = toCombinedQueryOptions[QueryOptions](world)
-	q.componentIdA = ComponentIdFor[A](world)
-	q.componentIdB = ComponentIdFor[B](world)
-	q.components = []ComponentId{
-		q.componentIdA,
-		q.componentIdB,
-	}
-	q.options.optimize(q.components)
-	return err
-}
-func (q *Query3[A, B, C, QueryOptions]) Prepare(world *World) (err error) {
-	q.options, err = toCombinedQueryOptions[QueryOptions](world)
-	q.componentIdA = ComponentIdFor[A](world)
-	q.componentIdB = ComponentIdFor[B](world)
-	q.componentIdC = ComponentIdFor[C](world)
-	q.components = []ComponentId{
-		q.componentIdA,
-		q.componentIdB,
-		q.componentIdC,
-	}
-	q.options.optimize(q.components)
-	return err
-}
-func (q *Query4[A, B, C, D, QueryOptions]) Prepare(world *World) (err error) {
-	q.options, err = toCombinedQueryOptions[QueryOptions](world)
-	q.componentIdA = ComponentIdFor[A](world)
-	q.componentIdB = ComponentIdFor[B](world)
-	q.componentIdC = ComponentIdFor[C](world)
-	q.componentIdD = ComponentIdFor[D](world)
-	q.components = []ComponentId{
-		q.componentIdA,
-		q.componentIdB,
-		q.componentIdC,
-		q.componentIdD,
-	}
-	q.options.optimize(q.components)
-	return err
-}
 
 func (q *Query0[QueryOptions]) Result() *Query0Result {
 	return &q.results
 }
-func (q *Query1[ComponentA, QueryOptions]) Result() *Query1Result[ComponentA] {
-	return &q.results
-}
-func (q *Query2[ComponentA, ComponentB, QueryOptions]) Result() *Query2Result[ComponentA, ComponentB] {
-	return &q.results
-}
-func (q *Query3[ComponentA, ComponentB, ComponentC, QueryOptions]) Result() *Query3Result[ComponentA, ComponentB, ComponentC] {
-	return &q.results
-}
-func (q *Query4[ComponentA, ComponentB, ComponentC, ComponentD, QueryOptions]) Result() *Query4Result[ComponentA, ComponentB, ComponentC, ComponentD] {
-	return &q.results
-}
 
 func (q *Query0[QueryOptions]) ClearResults() {
 	q.results.Clear()
 }
-func (q *Query1[ComponentA, QueryOptions]) ClearResults() {
-	q.results.Clear()
-}
-func (q *Query2[ComponentA, ComponentB, QueryOptions]) ClearResults() {
-	q.results.Clear()
-}
-func (q *Query3[ComponentA, ComponentB, ComponentC, QueryOptions]) ClearResults() {
-	q.results.Clear()
-}
-func (q *Query4[ComponentA, ComponentB, ComponentC, ComponentD, QueryOptions]) ClearResults() {
-	q.results.Clear()
-}
 
 // shouldHandleQueryComponent returns wether a component should be fetched and/or skipped:
 //   - shouldSkip=true means that the archetype should not be included in the query results.
@@ -473,16 +206,21 @@ func shouldHandleQueryComponent(componentId ComponentId, archetype *Archetype, q
 	return false, true
 }
 
-// fetchComponentForQueryResult fetches a component from the component storage
-func fetchComponentForQueryResult[T IComponent](componentId ComponentId, entityRow uint, archetype *Archetype, queryOptions *CombinedQueryOptions) (result *T, err error) {
+// fetchComponentForQueryResult fetches a component from the component storage. Components that are not read-only
+// are marked as changed with changeTick.
+func fetchComponentForQueryResult[T IComponent](componentId ComponentId, entityRow uint, archetype *Archetype, queryOptions *CombinedQueryOptions, changeTick uint) (result *T, err error) {
 	storage := archetype.components[componentId]
 	result, err = getComponentFromComponentStorage[T](storage, entityRow)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve component %s from storage: %v", componentId.DebugString(), err)
 	}
 
-	if result != nil && (queryOptions.ReadOnlyComponents.IsAllReadOnly || slices.Contains(queryOptions.ReadOnlyComponents.ComponentIds, componentId)) {
-		result = utils.ClonePointerValue(result)
+	if queryOptions.ReadOnlyComponents.IsAllReadOnly || slices.Contains(queryOptions.ReadOnlyComponents.ComponentIds, componentId) {
+		if result != nil {
+			result = utils.ClonePointerValue(result)
+		}
+	} else {
+		storage.markChanged(entityRow, changeTick)
 	}
 
 	return result, nil
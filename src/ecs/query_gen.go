@@ -0,0 +1,2747 @@
+// Code generated by internal/generate. DO NOT EDIT.
+
+package ecs
+
+// Query1 queries 1 component.
+//
+// Prepare must be called once before calling Execute.
+//
+// The following query options are available:
+//   - use [NoReadOnly], [AllReadOnly], [ReadOnly1] to specify if components are read-only.
+//     Marking components as read-only allows systems with queries as system-params to be run in parallel with
+//     other systems.
+//   - use [NoOptional], [Optional1] to mark components as optional. When a component is
+//     optional, entities do not have to have that component in order for it to return a result, as long as it
+//     has the other (not-optional) components.
+//   - use [NoFilter] to not use any filters
+//   - use [With] to make the results only include entities that has a specific component.
+//   - use [Without] to make the results only include entities that do not have a specific component.
+//   - use [Added] and [Changed] to make the results only include entities of which a specific component was
+//     added or changed since the query was last executed.
+//   - use [And] and [Or] to combine filters.
+type Query1[ComponentA IComponent, _ QueryOption] struct {
+	queryOptions
+	results      Query1Result[ComponentA]
+	componentIdA ComponentId
+}
+
+// Query2 queries 2 components.
+//
+// Prepare must be called once before calling Execute.
+//
+// The following query options are available:
+//   - use [NoReadOnly], [AllReadOnly], [ReadOnly1], [ReadOnly2] to specify if components are read-only.
+//     Marking components as read-only allows systems with queries as system-params to be run in parallel with
+//     other systems.
+//   - use [NoOptional], [Optional1], [Optional2] to mark components as optional. When a component is
+//     optional, entities do not have to have that component in order for it to return a result, as long as it
+//     has the other (not-optional) components.
+//   - use [NoFilter] to not use any filters
+//   - use [With] to make the results only include entities that has a specific component.
+//   - use [Without] to make the results only include entities that do not have a specific component.
+//   - use [Added] and [Changed] to make the results only include entities of which a specific component was
+//     added or changed since the query was last executed.
+//   - use [And] and [Or] to combine filters.
+type Query2[ComponentA, ComponentB IComponent, _ QueryOption] struct {
+	queryOptions
+	results      Query2Result[ComponentA, ComponentB]
+	componentIdA ComponentId
+	componentIdB ComponentId
+}
+
+// Query3 queries 3 components.
+//
+// Prepare must be called once before calling Execute.
+//
+// The following query options are available:
+//   - use [NoReadOnly], [AllReadOnly], [ReadOnly1], [ReadOnly2] (and so on) to specify if components are read-only.
+//     Marking components as read-only allows systems with queries as system-params to be run in parallel with
+//     other systems.
+//   - use [NoOptional], [Optional1], [Optional2] (and so on) to mark components as optional. When a component is
+//     optional, entities do not have to have that component in order for it to return a result, as long as it
+//     has the other (not-optional) components.
+//   - use [NoFilter] to not use any filters
+//   - use [With] to make the results only include entities that has a specific component.
+//   - use [Without] to make the results only include entities that do not have a specific component.
+//   - use [Added] and [Changed] to make the results only include entities of which a specific component was
+//     added or changed since the query was last executed.
+//   - use [And] and [Or] to combine filters.
+type Query3[ComponentA, ComponentB, ComponentC IComponent, _ QueryOption] struct {
+	queryOptions
+	results      Query3Result[ComponentA, ComponentB, ComponentC]
+	componentIdA ComponentId
+	componentIdB ComponentId
+	componentIdC ComponentId
+}
+
+// Query4 queries 4 components.
+//
+// Prepare must be called once before calling Execute.
+//
+// The following query options are available:
+//   - use [NoReadOnly], [AllReadOnly], [ReadOnly1], [ReadOnly2] (and so on) to specify if components are read-only.
+//     Marking components as read-only allows systems with queries as system-params to be run in parallel with
+//     other systems.
+//   - use [NoOptional], [Optional1], [Optional2] (and so on) to mark components as optional. When a component is
+//     optional, entities do not have to have that component in order for it to return a result, as long as it
+//     has the other (not-optional) components.
+//   - use [NoFilter] to not use any filters
+//   - use [With] to make the results only include entities that has a specific component.
+//   - use [Without] to make the results only include entities that do not have a specific component.
+//   - use [Added] and [Changed] to make the results only include entities of which a specific component was
+//     added or changed since the query was last executed.
+//   - use [And] and [Or] to combine filters.
+type Query4[ComponentA, ComponentB, ComponentC, ComponentD IComponent, _ QueryOption] struct {
+	queryOptions
+	results      Query4Result[ComponentA, ComponentB, ComponentC, ComponentD]
+	componentIdA ComponentId
+	componentIdB ComponentId
+	componentIdC ComponentId
+	componentIdD ComponentId
+}
+
+// Query5 queries 5 components.
+//
+// Prepare must be called once before calling Execute.
+//
+// The following query options are available:
+//   - use [NoReadOnly], [AllReadOnly], [ReadOnly1], [ReadOnly2] (and so on) to specify if components are read-only.
+//     Marking components as read-only allows systems with queries as system-params to be run in parallel with
+//     other systems.
+//   - use [NoOptional], [Optional1], [Optional2] (and so on) to mark components as optional. When a component is
+//     optional, entities do not have to have that component in order for it to return a result, as long as it
+//     has the other (not-optional) components.
+//   - use [NoFilter] to not use any filters
+//   - use [With] to make the results only include entities that has a specific component.
+//   - use [Without] to make the results only include entities that do not have a specific component.
+//   - use [Added] and [Changed] to make the results only include entities of which a specific component was
+//     added or changed since the query was last executed.
+//   - use [And] and [Or] to combine filters.
+type Query5[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE IComponent, _ QueryOption] struct {
+	queryOptions
+	results      Query5Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE]
+	componentIdA ComponentId
+	componentIdB ComponentId
+	componentIdC ComponentId
+	componentIdD ComponentId
+	componentIdE ComponentId
+}
+
+// Query6 queries 6 components.
+//
+// Prepare must be called once before calling Execute.
+//
+// The following query options are available:
+//   - use [NoReadOnly], [AllReadOnly], [ReadOnly1], [ReadOnly2] (and so on) to specify if components are read-only.
+//     Marking components as read-only allows systems with queries as system-params to be run in parallel with
+//     other systems.
+//   - use [NoOptional], [Optional1], [Optional2] (and so on) to mark components as optional. When a component is
+//     optional, entities do not have to have that component in order for it to return a result, as long as it
+//     has the other (not-optional) components.
+//   - use [NoFilter] to not use any filters
+//   - use [With] to make the results only include entities that has a specific component.
+//   - use [Without] to make the results only include entities that do not have a specific component.
+//   - use [Added] and [Changed] to make the results only include entities of which a specific component was
+//     added or changed since the query was last executed.
+//   - use [And] and [Or] to combine filters.
+type Query6[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF IComponent, _ QueryOption] struct {
+	queryOptions
+	results      Query6Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF]
+	componentIdA ComponentId
+	componentIdB ComponentId
+	componentIdC ComponentId
+	componentIdD ComponentId
+	componentIdE ComponentId
+	componentIdF ComponentId
+}
+
+// Query7 queries 7 components.
+//
+// Prepare must be called once before calling Execute.
+//
+// The following query options are available:
+//   - use [NoReadOnly], [AllReadOnly], [ReadOnly1], [ReadOnly2] (and so on) to specify if components are read-only.
+//     Marking components as read-only allows systems with queries as system-params to be run in parallel with
+//     other systems.
+//   - use [NoOptional], [Optional1], [Optional2] (and so on) to mark components as optional. When a component is
+//     optional, entities do not have to have that component in order for it to return a result, as long as it
+//     has the other (not-optional) components.
+//   - use [NoFilter] to not use any filters
+//   - use [With] to make the results only include entities that has a specific component.
+//   - use [Without] to make the results only include entities that do not have a specific component.
+//   - use [Added] and [Changed] to make the results only include entities of which a specific component was
+//     added or changed since the query was last executed.
+//   - use [And] and [Or] to combine filters.
+type Query7[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG IComponent, _ QueryOption] struct {
+	queryOptions
+	results      Query7Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG]
+	componentIdA ComponentId
+	componentIdB ComponentId
+	componentIdC ComponentId
+	componentIdD ComponentId
+	componentIdE ComponentId
+	componentIdF ComponentId
+	componentIdG ComponentId
+}
+
+// Query8 queries 8 components.
+//
+// Prepare must be called once before calling Execute.
+//
+// The following query options are available:
+//   - use [NoReadOnly], [AllReadOnly], [ReadOnly1], [ReadOnly2] (and so on) to specify if components are read-only.
+//     Marking components as read-only allows systems with queries as system-params to be run in parallel with
+//     other systems.
+//   - use [NoOptional], [Optional1], [Optional2] (and so on) to mark components as optional. When a component is
+//     optional, entities do not have to have that component in order for it to return a result, as long as it
+//     has the other (not-optional) components.
+//   - use [NoFilter] to not use any filters
+//   - use [With] to make the results only include entities that has a specific component.
+//   - use [Without] to make the results only include entities that do not have a specific component.
+//   - use [Added] and [Changed] to make the results only include entities of which a specific component was
+//     added or changed since the query was last executed.
+//   - use [And] and [Or] to combine filters.
+type Query8[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH IComponent, _ QueryOption] struct {
+	queryOptions
+	results      Query8Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH]
+	componentIdA ComponentId
+	componentIdB ComponentId
+	componentIdC ComponentId
+	componentIdD ComponentId
+	componentIdE ComponentId
+	componentIdF ComponentId
+	componentIdG ComponentId
+	componentIdH ComponentId
+}
+
+// Query9 queries 9 components.
+//
+// Prepare must be called once before calling Execute.
+//
+// The following query options are available:
+//   - use [NoReadOnly], [AllReadOnly], [ReadOnly1], [ReadOnly2] (and so on) to specify if components are read-only.
+//     Marking components as read-only allows systems with queries as system-params to be run in parallel with
+//     other systems.
+//   - use [NoOptional], [Optional1], [Optional2] (and so on) to mark components as optional. When a component is
+//     optional, entities do not have to have that component in order for it to return a result, as long as it
+//     has the other (not-optional) components.
+//   - use [NoFilter] to not use any filters
+//   - use [With] to make the results only include entities that has a specific component.
+//   - use [Without] to make the results only include entities that do not have a specific component.
+//   - use [Added] and [Changed] to make the results only include entities of which a specific component was
+//     added or changed since the query was last executed.
+//   - use [And] and [Or] to combine filters.
+type Query9[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI IComponent, _ QueryOption] struct {
+	queryOptions
+	results      Query9Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI]
+	componentIdA ComponentId
+	componentIdB ComponentId
+	componentIdC ComponentId
+	componentIdD ComponentId
+	componentIdE ComponentId
+	componentIdF ComponentId
+	componentIdG ComponentId
+	componentIdH ComponentId
+	componentIdI ComponentId
+}
+
+// Query10 queries 10 components.
+//
+// Prepare must be called once before calling Execute.
+//
+// The following query options are available:
+//   - use [NoReadOnly], [AllReadOnly], [ReadOnly1], [ReadOnly2] (and so on) to specify if components are read-only.
+//     Marking components as read-only allows systems with queries as system-params to be run in parallel with
+//     other systems.
+//   - use [NoOptional], [Optional1], [Optional2] (and so on) to mark components as optional. When a component is
+//     optional, entities do not have to have that component in order for it to return a result, as long as it
+//     has the other (not-optional) components.
+//   - use [NoFilter] to not use any filters
+//   - use [With] to make the results only include entities that has a specific component.
+//   - use [Without] to make the results only include entities that do not have a specific component.
+//   - use [Added] and [Changed] to make the results only include entities of which a specific component was
+//     added or changed since the query was last executed.
+//   - use [And] and [Or] to combine filters.
+type Query10[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ IComponent, _ QueryOption] struct {
+	queryOptions
+	results      Query10Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ]
+	componentIdA ComponentId
+	componentIdB ComponentId
+	componentIdC ComponentId
+	componentIdD ComponentId
+	componentIdE ComponentId
+	componentIdF ComponentId
+	componentIdG ComponentId
+	componentIdH ComponentId
+	componentIdI ComponentId
+	componentIdJ ComponentId
+}
+
+// Query11 queries 11 components.
+//
+// Prepare must be called once before calling Execute.
+//
+// The following query options are available:
+//   - use [NoReadOnly], [AllReadOnly], [ReadOnly1], [ReadOnly2] (and so on) to specify if components are read-only.
+//     Marking components as read-only allows systems with queries as system-params to be run in parallel with
+//     other systems.
+//   - use [NoOptional], [Optional1], [Optional2] (and so on) to mark components as optional. When a component is
+//     optional, entities do not have to have that component in order for it to return a result, as long as it
+//     has the other (not-optional) components.
+//   - use [NoFilter] to not use any filters
+//   - use [With] to make the results only include entities that has a specific component.
+//   - use [Without] to make the results only include entities that do not have a specific component.
+//   - use [Added] and [Changed] to make the results only include entities of which a specific component was
+//     added or changed since the query was last executed.
+//   - use [And] and [Or] to combine filters.
+type Query11[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK IComponent, _ QueryOption] struct {
+	queryOptions
+	results      Query11Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK]
+	componentIdA ComponentId
+	componentIdB ComponentId
+	componentIdC ComponentId
+	componentIdD ComponentId
+	componentIdE ComponentId
+	componentIdF ComponentId
+	componentIdG ComponentId
+	componentIdH ComponentId
+	componentIdI ComponentId
+	componentIdJ ComponentId
+	componentIdK ComponentId
+}
+
+// Query12 queries 12 components.
+//
+// Prepare must be called once before calling Execute.
+//
+// The following query options are available:
+//   - use [NoReadOnly], [AllReadOnly], [ReadOnly1], [ReadOnly2] (and so on) to specify if components are read-only.
+//     Marking components as read-only allows systems with queries as system-params to be run in parallel with
+//     other systems.
+//   - use [NoOptional], [Optional1], [Optional2] (and so on) to mark components as optional. When a component is
+//     optional, entities do not have to have that component in order for it to return a result, as long as it
+//     has the other (not-optional) components.
+//   - use [NoFilter] to not use any filters
+//   - use [With] to make the results only include entities that has a specific component.
+//   - use [Without] to make the results only include entities that do not have a specific component.
+//   - use [Added] and [Changed] to make the results only include entities of which a specific component was
+//     added or changed since the query was last executed.
+//   - use [And] and [Or] to combine filters.
+type Query12[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL IComponent, _ QueryOption] struct {
+	queryOptions
+	results      Query12Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL]
+	componentIdA ComponentId
+	componentIdB ComponentId
+	componentIdC ComponentId
+	componentIdD ComponentId
+	componentIdE ComponentId
+	componentIdF ComponentId
+	componentIdG ComponentId
+	componentIdH ComponentId
+	componentIdI ComponentId
+	componentIdJ ComponentId
+	componentIdK ComponentId
+	componentIdL ComponentId
+}
+
+// Query13 queries 13 components.
+//
+// Prepare must be called once before calling Execute.
+//
+// The following query options are available:
+//   - use [NoReadOnly], [AllReadOnly], [ReadOnly1], [ReadOnly2] (and so on) to specify if components are read-only.
+//     Marking components as read-only allows systems with queries as system-params to be run in parallel with
+//     other systems.
+//   - use [NoOptional], [Optional1], [Optional2] (and so on) to mark components as optional. When a component is
+//     optional, entities do not have to have that component in order for it to return a result, as long as it
+//     has the other (not-optional) components.
+//   - use [NoFilter] to not use any filters
+//   - use [With] to make the results only include entities that has a specific component.
+//   - use [Without] to make the results only include entities that do not have a specific component.
+//   - use [Added] and [Changed] to make the results only include entities of which a specific component was
+//     added or changed since the query was last executed.
+//   - use [And] and [Or] to combine filters.
+type Query13[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM IComponent, _ QueryOption] struct {
+	queryOptions
+	results      Query13Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM]
+	componentIdA ComponentId
+	componentIdB ComponentId
+	componentIdC ComponentId
+	componentIdD ComponentId
+	componentIdE ComponentId
+	componentIdF ComponentId
+	componentIdG ComponentId
+	componentIdH ComponentId
+	componentIdI ComponentId
+	componentIdJ ComponentId
+	componentIdK ComponentId
+	componentIdL ComponentId
+	componentIdM ComponentId
+}
+
+// Query14 queries 14 components.
+//
+// Prepare must be called once before calling Execute.
+//
+// The following query options are available:
+//   - use [NoReadOnly], [AllReadOnly], [ReadOnly1], [ReadOnly2] (and so on) to specify if components are read-only.
+//     Marking components as read-only allows systems with queries as system-params to be run in parallel with
+//     other systems.
+//   - use [NoOptional], [Optional1], [Optional2] (and so on) to mark components as optional. When a component is
+//     optional, entities do not have to have that component in order for it to return a result, as long as it
+//     has the other (not-optional) components.
+//   - use [NoFilter] to not use any filters
+//   - use [With] to make the results only include entities that has a specific component.
+//   - use [Without] to make the results only include entities that do not have a specific component.
+//   - use [Added] and [Changed] to make the results only include entities of which a specific component was
+//     added or changed since the query was last executed.
+//   - use [And] and [Or] to combine filters.
+type Query14[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM, ComponentN IComponent, _ QueryOption] struct {
+	queryOptions
+	results      Query14Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM, ComponentN]
+	componentIdA ComponentId
+	componentIdB ComponentId
+	componentIdC ComponentId
+	componentIdD ComponentId
+	componentIdE ComponentId
+	componentIdF ComponentId
+	componentIdG ComponentId
+	componentIdH ComponentId
+	componentIdI ComponentId
+	componentIdJ ComponentId
+	componentIdK ComponentId
+	componentIdL ComponentId
+	componentIdM ComponentId
+	componentIdN ComponentId
+}
+
+// Query15 queries 15 components.
+//
+// Prepare must be called once before calling Execute.
+//
+// The following query options are available:
+//   - use [NoReadOnly], [AllReadOnly], [ReadOnly1], [ReadOnly2] (and so on) to specify if components are read-only.
+//     Marking components as read-only allows systems with queries as system-params to be run in parallel with
+//     other systems.
+//   - use [NoOptional], [Optional1], [Optional2] (and so on) to mark components as optional. When a component is
+//     optional, entities do not have to have that component in order for it to return a result, as long as it
+//     has the other (not-optional) components.
+//   - use [NoFilter] to not use any filters
+//   - use [With] to make the results only include entities that has a specific component.
+//   - use [Without] to make the results only include entities that do not have a specific component.
+//   - use [Added] and [Changed] to make the results only include entities of which a specific component was
+//     added or changed since the query was last executed.
+//   - use [And] and [Or] to combine filters.
+type Query15[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM, ComponentN, ComponentO IComponent, _ QueryOption] struct {
+	queryOptions
+	results      Query15Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM, ComponentN, ComponentO]
+	componentIdA ComponentId
+	componentIdB ComponentId
+	componentIdC ComponentId
+	componentIdD ComponentId
+	componentIdE ComponentId
+	componentIdF ComponentId
+	componentIdG ComponentId
+	componentIdH ComponentId
+	componentIdI ComponentId
+	componentIdJ ComponentId
+	componentIdK ComponentId
+	componentIdL ComponentId
+	componentIdM ComponentId
+	componentIdN ComponentId
+	componentIdO ComponentId
+}
+
+// Query16 queries 16 components.
+//
+// Prepare must be called once before calling Execute.
+//
+// The following query options are available:
+//   - use [NoReadOnly], [AllReadOnly], [ReadOnly1], [ReadOnly2] (and so on) to specify if components are read-only.
+//     Marking components as read-only allows systems with queries as system-params to be run in parallel with
+//     other systems.
+//   - use [NoOptional], [Optional1], [Optional2] (and so on) to mark components as optional. When a component is
+//     optional, entities do not have to have that component in order for it to return a result, as long as it
+//     has the other (not-optional) components.
+//   - use [NoFilter] to not use any filters
+//   - use [With] to make the results only include entities that has a specific component.
+//   - use [Without] to make the results only include entities that do not have a specific component.
+//   - use [Added] and [Changed] to make the results only include entities of which a specific component was
+//     added or changed since the query was last executed.
+//   - use [And] and [Or] to combine filters.
+type Query16[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM, ComponentN, ComponentO, ComponentP IComponent, _ QueryOption] struct {
+	queryOptions
+	results      Query16Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM, ComponentN, ComponentO, ComponentP]
+	componentIdA ComponentId
+	componentIdB ComponentId
+	componentIdC ComponentId
+	componentIdD ComponentId
+	componentIdE ComponentId
+	componentIdF ComponentId
+	componentIdG ComponentId
+	componentIdH ComponentId
+	componentIdI ComponentId
+	componentIdJ ComponentId
+	componentIdK ComponentId
+	componentIdL ComponentId
+	componentIdM ComponentId
+	componentIdN ComponentId
+	componentIdO ComponentId
+	componentIdP ComponentId
+}
+
+func (q *Query1[ComponentA, QueryOptions]) Exec(world *World) (err error) {
+	q.ClearResults()
+
+	archetypes := q.matchingArchetypes(world)
+	lastRun, thisRun := q.advanceChangeTick(world)
+	usesTicks := q.options.usesTicks()
+
+	for _, archetype := range archetypes {
+		fetchA := archetype.HasComponent(q.componentIdA)
+
+		for _, entity := range archetype.entities {
+			entityData := &world.entities[entity.index]
+			if usesTicks && q.options.isEntityFilteredOutByTicks(entityData, lastRun) {
+				continue
+			}
+
+			var a *ComponentA
+			if fetchA {
+				a, err = fetchComponentForQueryResult[ComponentA](q.componentIdA, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			q.results.componentsA = append(q.results.componentsA, a)
+			q.results.entityIds = append(q.results.entityIds, entity)
+		}
+	}
+
+	return nil
+}
+
+func (q *Query2[ComponentA, ComponentB, QueryOptions]) Exec(world *World) (err error) {
+	q.ClearResults()
+
+	archetypes := q.matchingArchetypes(world)
+	lastRun, thisRun := q.advanceChangeTick(world)
+	usesTicks := q.options.usesTicks()
+
+	for _, archetype := range archetypes {
+		fetchA := archetype.HasComponent(q.componentIdA)
+		fetchB := archetype.HasComponent(q.componentIdB)
+
+		for _, entity := range archetype.entities {
+			entityData := &world.entities[entity.index]
+			if usesTicks && q.options.isEntityFilteredOutByTicks(entityData, lastRun) {
+				continue
+			}
+
+			var a *ComponentA
+			if fetchA {
+				a, err = fetchComponentForQueryResult[ComponentA](q.componentIdA, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var b *ComponentB
+			if fetchB {
+				b, err = fetchComponentForQueryResult[ComponentB](q.componentIdB, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			q.results.componentsA = append(q.results.componentsA, a)
+			q.results.componentsB = append(q.results.componentsB, b)
+			q.results.entityIds = append(q.results.entityIds, entity)
+		}
+	}
+
+	return nil
+}
+
+func (q *Query3[ComponentA, ComponentB, ComponentC, QueryOptions]) Exec(world *World) (err error) {
+	q.ClearResults()
+
+	archetypes := q.matchingArchetypes(world)
+	lastRun, thisRun := q.advanceChangeTick(world)
+	usesTicks := q.options.usesTicks()
+
+	for _, archetype := range archetypes {
+		fetchA := archetype.HasComponent(q.componentIdA)
+		fetchB := archetype.HasComponent(q.componentIdB)
+		fetchC := archetype.HasComponent(q.componentIdC)
+
+		for _, entity := range archetype.entities {
+			entityData := &world.entities[entity.index]
+			if usesTicks && q.options.isEntityFilteredOutByTicks(entityData, lastRun) {
+				continue
+			}
+
+			var a *ComponentA
+			if fetchA {
+				a, err = fetchComponentForQueryResult[ComponentA](q.componentIdA, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var b *ComponentB
+			if fetchB {
+				b, err = fetchComponentForQueryResult[ComponentB](q.componentIdB, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var c *ComponentC
+			if fetchC {
+				c, err = fetchComponentForQueryResult[ComponentC](q.componentIdC, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			q.results.componentsA = append(q.results.componentsA, a)
+			q.results.componentsB = append(q.results.componentsB, b)
+			q.results.componentsC = append(q.results.componentsC, c)
+			q.results.entityIds = append(q.results.entityIds, entity)
+		}
+	}
+
+	return nil
+}
+
+func (q *Query4[ComponentA, ComponentB, ComponentC, ComponentD, QueryOptions]) Exec(world *World) (err error) {
+	q.ClearResults()
+
+	archetypes := q.matchingArchetypes(world)
+	lastRun, thisRun := q.advanceChangeTick(world)
+	usesTicks := q.options.usesTicks()
+
+	for _, archetype := range archetypes {
+		fetchA := archetype.HasComponent(q.componentIdA)
+		fetchB := archetype.HasComponent(q.componentIdB)
+		fetchC := archetype.HasComponent(q.componentIdC)
+		fetchD := archetype.HasComponent(q.componentIdD)
+
+		for _, entity := range archetype.entities {
+			entityData := &world.entities[entity.index]
+			if usesTicks && q.options.isEntityFilteredOutByTicks(entityData, lastRun) {
+				continue
+			}
+
+			var a *ComponentA
+			if fetchA {
+				a, err = fetchComponentForQueryResult[ComponentA](q.componentIdA, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var b *ComponentB
+			if fetchB {
+				b, err = fetchComponentForQueryResult[ComponentB](q.componentIdB, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var c *ComponentC
+			if fetchC {
+				c, err = fetchComponentForQueryResult[ComponentC](q.componentIdC, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var d *ComponentD
+			if fetchD {
+				d, err = fetchComponentForQueryResult[ComponentD](q.componentIdD, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			q.results.componentsA = append(q.results.componentsA, a)
+			q.results.componentsB = append(q.results.componentsB, b)
+			q.results.componentsC = append(q.results.componentsC, c)
+			q.results.componentsD = append(q.results.componentsD, d)
+			q.results.entityIds = append(q.results.entityIds, entity)
+		}
+	}
+
+	return nil
+}
+
+func (q *Query5[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, QueryOptions]) Exec(world *World) (err error) {
+	q.ClearResults()
+
+	archetypes := q.matchingArchetypes(world)
+	lastRun, thisRun := q.advanceChangeTick(world)
+	usesTicks := q.options.usesTicks()
+
+	for _, archetype := range archetypes {
+		fetchA := archetype.HasComponent(q.componentIdA)
+		fetchB := archetype.HasComponent(q.componentIdB)
+		fetchC := archetype.HasComponent(q.componentIdC)
+		fetchD := archetype.HasComponent(q.componentIdD)
+		fetchE := archetype.HasComponent(q.componentIdE)
+
+		for _, entity := range archetype.entities {
+			entityData := &world.entities[entity.index]
+			if usesTicks && q.options.isEntityFilteredOutByTicks(entityData, lastRun) {
+				continue
+			}
+
+			var a *ComponentA
+			if fetchA {
+				a, err = fetchComponentForQueryResult[ComponentA](q.componentIdA, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var b *ComponentB
+			if fetchB {
+				b, err = fetchComponentForQueryResult[ComponentB](q.componentIdB, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var c *ComponentC
+			if fetchC {
+				c, err = fetchComponentForQueryResult[ComponentC](q.componentIdC, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var d *ComponentD
+			if fetchD {
+				d, err = fetchComponentForQueryResult[ComponentD](q.componentIdD, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var e *ComponentE
+			if fetchE {
+				e, err = fetchComponentForQueryResult[ComponentE](q.componentIdE, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			q.results.componentsA = append(q.results.componentsA, a)
+			q.results.componentsB = append(q.results.componentsB, b)
+			q.results.componentsC = append(q.results.componentsC, c)
+			q.results.componentsD = append(q.results.componentsD, d)
+			q.results.componentsE = append(q.results.componentsE, e)
+			q.results.entityIds = append(q.results.entityIds, entity)
+		}
+	}
+
+	return nil
+}
+
+func (q *Query6[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, QueryOptions]) Exec(world *World) (err error) {
+	q.ClearResults()
+
+	archetypes := q.matchingArchetypes(world)
+	lastRun, thisRun := q.advanceChangeTick(world)
+	usesTicks := q.options.usesTicks()
+
+	for _, archetype := range archetypes {
+		fetchA := archetype.HasComponent(q.componentIdA)
+		fetchB := archetype.HasComponent(q.componentIdB)
+		fetchC := archetype.HasComponent(q.componentIdC)
+		fetchD := archetype.HasComponent(q.componentIdD)
+		fetchE := archetype.HasComponent(q.componentIdE)
+		fetchF := archetype.HasComponent(q.componentIdF)
+
+		for _, entity := range archetype.entities {
+			entityData := &world.entities[entity.index]
+			if usesTicks && q.options.isEntityFilteredOutByTicks(entityData, lastRun) {
+				continue
+			}
+
+			var a *ComponentA
+			if fetchA {
+				a, err = fetchComponentForQueryResult[ComponentA](q.componentIdA, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var b *ComponentB
+			if fetchB {
+				b, err = fetchComponentForQueryResult[ComponentB](q.componentIdB, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var c *ComponentC
+			if fetchC {
+				c, err = fetchComponentForQueryResult[ComponentC](q.componentIdC, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var d *ComponentD
+			if fetchD {
+				d, err = fetchComponentForQueryResult[ComponentD](q.componentIdD, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var e *ComponentE
+			if fetchE {
+				e, err = fetchComponentForQueryResult[ComponentE](q.componentIdE, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var f *ComponentF
+			if fetchF {
+				f, err = fetchComponentForQueryResult[ComponentF](q.componentIdF, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			q.results.componentsA = append(q.results.componentsA, a)
+			q.results.componentsB = append(q.results.componentsB, b)
+			q.results.componentsC = append(q.results.componentsC, c)
+			q.results.componentsD = append(q.results.componentsD, d)
+			q.results.componentsE = append(q.results.componentsE, e)
+			q.results.componentsF = append(q.results.componentsF, f)
+			q.results.entityIds = append(q.results.entityIds, entity)
+		}
+	}
+
+	return nil
+}
+
+func (q *Query7[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, QueryOptions]) Exec(world *World) (err error) {
+	q.ClearResults()
+
+	archetypes := q.matchingArchetypes(world)
+	lastRun, thisRun := q.advanceChangeTick(world)
+	usesTicks := q.options.usesTicks()
+
+	for _, archetype := range archetypes {
+		fetchA := archetype.HasComponent(q.componentIdA)
+		fetchB := archetype.HasComponent(q.componentIdB)
+		fetchC := archetype.HasComponent(q.componentIdC)
+		fetchD := archetype.HasComponent(q.componentIdD)
+		fetchE := archetype.HasComponent(q.componentIdE)
+		fetchF := archetype.HasComponent(q.componentIdF)
+		fetchG := archetype.HasComponent(q.componentIdG)
+
+		for _, entity := range archetype.entities {
+			entityData := &world.entities[entity.index]
+			if usesTicks && q.options.isEntityFilteredOutByTicks(entityData, lastRun) {
+				continue
+			}
+
+			var a *ComponentA
+			if fetchA {
+				a, err = fetchComponentForQueryResult[ComponentA](q.componentIdA, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var b *ComponentB
+			if fetchB {
+				b, err = fetchComponentForQueryResult[ComponentB](q.componentIdB, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var c *ComponentC
+			if fetchC {
+				c, err = fetchComponentForQueryResult[ComponentC](q.componentIdC, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var d *ComponentD
+			if fetchD {
+				d, err = fetchComponentForQueryResult[ComponentD](q.componentIdD, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var e *ComponentE
+			if fetchE {
+				e, err = fetchComponentForQueryResult[ComponentE](q.componentIdE, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var f *ComponentF
+			if fetchF {
+				f, err = fetchComponentForQueryResult[ComponentF](q.componentIdF, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var g *ComponentG
+			if fetchG {
+				g, err = fetchComponentForQueryResult[ComponentG](q.componentIdG, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			q.results.componentsA = append(q.results.componentsA, a)
+			q.results.componentsB = append(q.results.componentsB, b)
+			q.results.componentsC = append(q.results.componentsC, c)
+			q.results.componentsD = append(q.results.componentsD, d)
+			q.results.componentsE = append(q.results.componentsE, e)
+			q.results.componentsF = append(q.results.componentsF, f)
+			q.results.componentsG = append(q.results.componentsG, g)
+			q.results.entityIds = append(q.results.entityIds, entity)
+		}
+	}
+
+	return nil
+}
+
+func (q *Query8[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, QueryOptions]) Exec(world *World) (err error) {
+	q.ClearResults()
+
+	archetypes := q.matchingArchetypes(world)
+	lastRun, thisRun := q.advanceChangeTick(world)
+	usesTicks := q.options.usesTicks()
+
+	for _, archetype := range archetypes {
+		fetchA := archetype.HasComponent(q.componentIdA)
+		fetchB := archetype.HasComponent(q.componentIdB)
+		fetchC := archetype.HasComponent(q.componentIdC)
+		fetchD := archetype.HasComponent(q.componentIdD)
+		fetchE := archetype.HasComponent(q.componentIdE)
+		fetchF := archetype.HasComponent(q.componentIdF)
+		fetchG := archetype.HasComponent(q.componentIdG)
+		fetchH := archetype.HasComponent(q.componentIdH)
+
+		for _, entity := range archetype.entities {
+			entityData := &world.entities[entity.index]
+			if usesTicks && q.options.isEntityFilteredOutByTicks(entityData, lastRun) {
+				continue
+			}
+
+			var a *ComponentA
+			if fetchA {
+				a, err = fetchComponentForQueryResult[ComponentA](q.componentIdA, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var b *ComponentB
+			if fetchB {
+				b, err = fetchComponentForQueryResult[ComponentB](q.componentIdB, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var c *ComponentC
+			if fetchC {
+				c, err = fetchComponentForQueryResult[ComponentC](q.componentIdC, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var d *ComponentD
+			if fetchD {
+				d, err = fetchComponentForQueryResult[ComponentD](q.componentIdD, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var e *ComponentE
+			if fetchE {
+				e, err = fetchComponentForQueryResult[ComponentE](q.componentIdE, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var f *ComponentF
+			if fetchF {
+				f, err = fetchComponentForQueryResult[ComponentF](q.componentIdF, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var g *ComponentG
+			if fetchG {
+				g, err = fetchComponentForQueryResult[ComponentG](q.componentIdG, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var h *ComponentH
+			if fetchH {
+				h, err = fetchComponentForQueryResult[ComponentH](q.componentIdH, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			q.results.componentsA = append(q.results.componentsA, a)
+			q.results.componentsB = append(q.results.componentsB, b)
+			q.results.componentsC = append(q.results.componentsC, c)
+			q.results.componentsD = append(q.results.componentsD, d)
+			q.results.componentsE = append(q.results.componentsE, e)
+			q.results.componentsF = append(q.results.componentsF, f)
+			q.results.componentsG = append(q.results.componentsG, g)
+			q.results.componentsH = append(q.results.componentsH, h)
+			q.results.entityIds = append(q.results.entityIds, entity)
+		}
+	}
+
+	return nil
+}
+
+func (q *Query9[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, QueryOptions]) Exec(world *World) (err error) {
+	q.ClearResults()
+
+	archetypes := q.matchingArchetypes(world)
+	lastRun, thisRun := q.advanceChangeTick(world)
+	usesTicks := q.options.usesTicks()
+
+	for _, archetype := range archetypes {
+		fetchA := archetype.HasComponent(q.componentIdA)
+		fetchB := archetype.HasComponent(q.componentIdB)
+		fetchC := archetype.HasComponent(q.componentIdC)
+		fetchD := archetype.HasComponent(q.componentIdD)
+		fetchE := archetype.HasComponent(q.componentIdE)
+		fetchF := archetype.HasComponent(q.componentIdF)
+		fetchG := archetype.HasComponent(q.componentIdG)
+		fetchH := archetype.HasComponent(q.componentIdH)
+		fetchI := archetype.HasComponent(q.componentIdI)
+
+		for _, entity := range archetype.entities {
+			entityData := &world.entities[entity.index]
+			if usesTicks && q.options.isEntityFilteredOutByTicks(entityData, lastRun) {
+				continue
+			}
+
+			var a *ComponentA
+			if fetchA {
+				a, err = fetchComponentForQueryResult[ComponentA](q.componentIdA, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var b *ComponentB
+			if fetchB {
+				b, err = fetchComponentForQueryResult[ComponentB](q.componentIdB, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var c *ComponentC
+			if fetchC {
+				c, err = fetchComponentForQueryResult[ComponentC](q.componentIdC, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var d *ComponentD
+			if fetchD {
+				d, err = fetchComponentForQueryResult[ComponentD](q.componentIdD, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var e *ComponentE
+			if fetchE {
+				e, err = fetchComponentForQueryResult[ComponentE](q.componentIdE, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var f *ComponentF
+			if fetchF {
+				f, err = fetchComponentForQueryResult[ComponentF](q.componentIdF, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var g *ComponentG
+			if fetchG {
+				g, err = fetchComponentForQueryResult[ComponentG](q.componentIdG, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var h *ComponentH
+			if fetchH {
+				h, err = fetchComponentForQueryResult[ComponentH](q.componentIdH, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var i *ComponentI
+			if fetchI {
+				i, err = fetchComponentForQueryResult[ComponentI](q.componentIdI, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			q.results.componentsA = append(q.results.componentsA, a)
+			q.results.componentsB = append(q.results.componentsB, b)
+			q.results.componentsC = append(q.results.componentsC, c)
+			q.results.componentsD = append(q.results.componentsD, d)
+			q.results.componentsE = append(q.results.componentsE, e)
+			q.results.componentsF = append(q.results.componentsF, f)
+			q.results.componentsG = append(q.results.componentsG, g)
+			q.results.componentsH = append(q.results.componentsH, h)
+			q.results.componentsI = append(q.results.componentsI, i)
+			q.results.entityIds = append(q.results.entityIds, entity)
+		}
+	}
+
+	return nil
+}
+
+func (q *Query10[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, QueryOptions]) Exec(world *World) (err error) {
+	q.ClearResults()
+
+	archetypes := q.matchingArchetypes(world)
+	lastRun, thisRun := q.advanceChangeTick(world)
+	usesTicks := q.options.usesTicks()
+
+	for _, archetype := range archetypes {
+		fetchA := archetype.HasComponent(q.componentIdA)
+		fetchB := archetype.HasComponent(q.componentIdB)
+		fetchC := archetype.HasComponent(q.componentIdC)
+		fetchD := archetype.HasComponent(q.componentIdD)
+		fetchE := archetype.HasComponent(q.componentIdE)
+		fetchF := archetype.HasComponent(q.componentIdF)
+		fetchG := archetype.HasComponent(q.componentIdG)
+		fetchH := archetype.HasComponent(q.componentIdH)
+		fetchI := archetype.HasComponent(q.componentIdI)
+		fetchJ := archetype.HasComponent(q.componentIdJ)
+
+		for _, entity := range archetype.entities {
+			entityData := &world.entities[entity.index]
+			if usesTicks && q.options.isEntityFilteredOutByTicks(entityData, lastRun) {
+				continue
+			}
+
+			var a *ComponentA
+			if fetchA {
+				a, err = fetchComponentForQueryResult[ComponentA](q.componentIdA, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var b *ComponentB
+			if fetchB {
+				b, err = fetchComponentForQueryResult[ComponentB](q.componentIdB, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var c *ComponentC
+			if fetchC {
+				c, err = fetchComponentForQueryResult[ComponentC](q.componentIdC, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var d *ComponentD
+			if fetchD {
+				d, err = fetchComponentForQueryResult[ComponentD](q.componentIdD, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var e *ComponentE
+			if fetchE {
+				e, err = fetchComponentForQueryResult[ComponentE](q.componentIdE, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var f *ComponentF
+			if fetchF {
+				f, err = fetchComponentForQueryResult[ComponentF](q.componentIdF, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var g *ComponentG
+			if fetchG {
+				g, err = fetchComponentForQueryResult[ComponentG](q.componentIdG, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var h *ComponentH
+			if fetchH {
+				h, err = fetchComponentForQueryResult[ComponentH](q.componentIdH, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var i *ComponentI
+			if fetchI {
+				i, err = fetchComponentForQueryResult[ComponentI](q.componentIdI, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var j *ComponentJ
+			if fetchJ {
+				j, err = fetchComponentForQueryResult[ComponentJ](q.componentIdJ, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			q.results.componentsA = append(q.results.componentsA, a)
+			q.results.componentsB = append(q.results.componentsB, b)
+			q.results.componentsC = append(q.results.componentsC, c)
+			q.results.componentsD = append(q.results.componentsD, d)
+			q.results.componentsE = append(q.results.componentsE, e)
+			q.results.componentsF = append(q.results.componentsF, f)
+			q.results.componentsG = append(q.results.componentsG, g)
+			q.results.componentsH = append(q.results.componentsH, h)
+			q.results.componentsI = append(q.results.componentsI, i)
+			q.results.componentsJ = append(q.results.componentsJ, j)
+			q.results.entityIds = append(q.results.entityIds, entity)
+		}
+	}
+
+	return nil
+}
+
+func (q *Query11[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, QueryOptions]) Exec(world *World) (err error) {
+	q.ClearResults()
+
+	archetypes := q.matchingArchetypes(world)
+	lastRun, thisRun := q.advanceChangeTick(world)
+	usesTicks := q.options.usesTicks()
+
+	for _, archetype := range archetypes {
+		fetchA := archetype.HasComponent(q.componentIdA)
+		fetchB := archetype.HasComponent(q.componentIdB)
+		fetchC := archetype.HasComponent(q.componentIdC)
+		fetchD := archetype.HasComponent(q.componentIdD)
+		fetchE := archetype.HasComponent(q.componentIdE)
+		fetchF := archetype.HasComponent(q.componentIdF)
+		fetchG := archetype.HasComponent(q.componentIdG)
+		fetchH := archetype.HasComponent(q.componentIdH)
+		fetchI := archetype.HasComponent(q.componentIdI)
+		fetchJ := archetype.HasComponent(q.componentIdJ)
+		fetchK := archetype.HasComponent(q.componentIdK)
+
+		for _, entity := range archetype.entities {
+			entityData := &world.entities[entity.index]
+			if usesTicks && q.options.isEntityFilteredOutByTicks(entityData, lastRun) {
+				continue
+			}
+
+			var a *ComponentA
+			if fetchA {
+				a, err = fetchComponentForQueryResult[ComponentA](q.componentIdA, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var b *ComponentB
+			if fetchB {
+				b, err = fetchComponentForQueryResult[ComponentB](q.componentIdB, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var c *ComponentC
+			if fetchC {
+				c, err = fetchComponentForQueryResult[ComponentC](q.componentIdC, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var d *ComponentD
+			if fetchD {
+				d, err = fetchComponentForQueryResult[ComponentD](q.componentIdD, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var e *ComponentE
+			if fetchE {
+				e, err = fetchComponentForQueryResult[ComponentE](q.componentIdE, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var f *ComponentF
+			if fetchF {
+				f, err = fetchComponentForQueryResult[ComponentF](q.componentIdF, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var g *ComponentG
+			if fetchG {
+				g, err = fetchComponentForQueryResult[ComponentG](q.componentIdG, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var h *ComponentH
+			if fetchH {
+				h, err = fetchComponentForQueryResult[ComponentH](q.componentIdH, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var i *ComponentI
+			if fetchI {
+				i, err = fetchComponentForQueryResult[ComponentI](q.componentIdI, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var j *ComponentJ
+			if fetchJ {
+				j, err = fetchComponentForQueryResult[ComponentJ](q.componentIdJ, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var k *ComponentK
+			if fetchK {
+				k, err = fetchComponentForQueryResult[ComponentK](q.componentIdK, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			q.results.componentsA = append(q.results.componentsA, a)
+			q.results.componentsB = append(q.results.componentsB, b)
+			q.results.componentsC = append(q.results.componentsC, c)
+			q.results.componentsD = append(q.results.componentsD, d)
+			q.results.componentsE = append(q.results.componentsE, e)
+			q.results.componentsF = append(q.results.componentsF, f)
+			q.results.componentsG = append(q.results.componentsG, g)
+			q.results.componentsH = append(q.results.componentsH, h)
+			q.results.componentsI = append(q.results.componentsI, i)
+			q.results.componentsJ = append(q.results.componentsJ, j)
+			q.results.componentsK = append(q.results.componentsK, k)
+			q.results.entityIds = append(q.results.entityIds, entity)
+		}
+	}
+
+	return nil
+}
+
+func (q *Query12[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, QueryOptions]) Exec(world *World) (err error) {
+	q.ClearResults()
+
+	archetypes := q.matchingArchetypes(world)
+	lastRun, thisRun := q.advanceChangeTick(world)
+	usesTicks := q.options.usesTicks()
+
+	for _, archetype := range archetypes {
+		fetchA := archetype.HasComponent(q.componentIdA)
+		fetchB := archetype.HasComponent(q.componentIdB)
+		fetchC := archetype.HasComponent(q.componentIdC)
+		fetchD := archetype.HasComponent(q.componentIdD)
+		fetchE := archetype.HasComponent(q.componentIdE)
+		fetchF := archetype.HasComponent(q.componentIdF)
+		fetchG := archetype.HasComponent(q.componentIdG)
+		fetchH := archetype.HasComponent(q.componentIdH)
+		fetchI := archetype.HasComponent(q.componentIdI)
+		fetchJ := archetype.HasComponent(q.componentIdJ)
+		fetchK := archetype.HasComponent(q.componentIdK)
+		fetchL := archetype.HasComponent(q.componentIdL)
+
+		for _, entity := range archetype.entities {
+			entityData := &world.entities[entity.index]
+			if usesTicks && q.options.isEntityFilteredOutByTicks(entityData, lastRun) {
+				continue
+			}
+
+			var a *ComponentA
+			if fetchA {
+				a, err = fetchComponentForQueryResult[ComponentA](q.componentIdA, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var b *ComponentB
+			if fetchB {
+				b, err = fetchComponentForQueryResult[ComponentB](q.componentIdB, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var c *ComponentC
+			if fetchC {
+				c, err = fetchComponentForQueryResult[ComponentC](q.componentIdC, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var d *ComponentD
+			if fetchD {
+				d, err = fetchComponentForQueryResult[ComponentD](q.componentIdD, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var e *ComponentE
+			if fetchE {
+				e, err = fetchComponentForQueryResult[ComponentE](q.componentIdE, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var f *ComponentF
+			if fetchF {
+				f, err = fetchComponentForQueryResult[ComponentF](q.componentIdF, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var g *ComponentG
+			if fetchG {
+				g, err = fetchComponentForQueryResult[ComponentG](q.componentIdG, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var h *ComponentH
+			if fetchH {
+				h, err = fetchComponentForQueryResult[ComponentH](q.componentIdH, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var i *ComponentI
+			if fetchI {
+				i, err = fetchComponentForQueryResult[ComponentI](q.componentIdI, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var j *ComponentJ
+			if fetchJ {
+				j, err = fetchComponentForQueryResult[ComponentJ](q.componentIdJ, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var k *ComponentK
+			if fetchK {
+				k, err = fetchComponentForQueryResult[ComponentK](q.componentIdK, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var l *ComponentL
+			if fetchL {
+				l, err = fetchComponentForQueryResult[ComponentL](q.componentIdL, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			q.results.componentsA = append(q.results.componentsA, a)
+			q.results.componentsB = append(q.results.componentsB, b)
+			q.results.componentsC = append(q.results.componentsC, c)
+			q.results.componentsD = append(q.results.componentsD, d)
+			q.results.componentsE = append(q.results.componentsE, e)
+			q.results.componentsF = append(q.results.componentsF, f)
+			q.results.componentsG = append(q.results.componentsG, g)
+			q.results.componentsH = append(q.results.componentsH, h)
+			q.results.componentsI = append(q.results.componentsI, i)
+			q.results.componentsJ = append(q.results.componentsJ, j)
+			q.results.componentsK = append(q.results.componentsK, k)
+			q.results.componentsL = append(q.results.componentsL, l)
+			q.results.entityIds = append(q.results.entityIds, entity)
+		}
+	}
+
+	return nil
+}
+
+func (q *Query13[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM, QueryOptions]) Exec(world *World) (err error) {
+	q.ClearResults()
+
+	archetypes := q.matchingArchetypes(world)
+	lastRun, thisRun := q.advanceChangeTick(world)
+	usesTicks := q.options.usesTicks()
+
+	for _, archetype := range archetypes {
+		fetchA := archetype.HasComponent(q.componentIdA)
+		fetchB := archetype.HasComponent(q.componentIdB)
+		fetchC := archetype.HasComponent(q.componentIdC)
+		fetchD := archetype.HasComponent(q.componentIdD)
+		fetchE := archetype.HasComponent(q.componentIdE)
+		fetchF := archetype.HasComponent(q.componentIdF)
+		fetchG := archetype.HasComponent(q.componentIdG)
+		fetchH := archetype.HasComponent(q.componentIdH)
+		fetchI := archetype.HasComponent(q.componentIdI)
+		fetchJ := archetype.HasComponent(q.componentIdJ)
+		fetchK := archetype.HasComponent(q.componentIdK)
+		fetchL := archetype.HasComponent(q.componentIdL)
+		fetchM := archetype.HasComponent(q.componentIdM)
+
+		for _, entity := range archetype.entities {
+			entityData := &world.entities[entity.index]
+			if usesTicks && q.options.isEntityFilteredOutByTicks(entityData, lastRun) {
+				continue
+			}
+
+			var a *ComponentA
+			if fetchA {
+				a, err = fetchComponentForQueryResult[ComponentA](q.componentIdA, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var b *ComponentB
+			if fetchB {
+				b, err = fetchComponentForQueryResult[ComponentB](q.componentIdB, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var c *ComponentC
+			if fetchC {
+				c, err = fetchComponentForQueryResult[ComponentC](q.componentIdC, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var d *ComponentD
+			if fetchD {
+				d, err = fetchComponentForQueryResult[ComponentD](q.componentIdD, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var e *ComponentE
+			if fetchE {
+				e, err = fetchComponentForQueryResult[ComponentE](q.componentIdE, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var f *ComponentF
+			if fetchF {
+				f, err = fetchComponentForQueryResult[ComponentF](q.componentIdF, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var g *ComponentG
+			if fetchG {
+				g, err = fetchComponentForQueryResult[ComponentG](q.componentIdG, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var h *ComponentH
+			if fetchH {
+				h, err = fetchComponentForQueryResult[ComponentH](q.componentIdH, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var i *ComponentI
+			if fetchI {
+				i, err = fetchComponentForQueryResult[ComponentI](q.componentIdI, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var j *ComponentJ
+			if fetchJ {
+				j, err = fetchComponentForQueryResult[ComponentJ](q.componentIdJ, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var k *ComponentK
+			if fetchK {
+				k, err = fetchComponentForQueryResult[ComponentK](q.componentIdK, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var l *ComponentL
+			if fetchL {
+				l, err = fetchComponentForQueryResult[ComponentL](q.componentIdL, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var m *ComponentM
+			if fetchM {
+				m, err = fetchComponentForQueryResult[ComponentM](q.componentIdM, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			q.results.componentsA = append(q.results.componentsA, a)
+			q.results.componentsB = append(q.results.componentsB, b)
+			q.results.componentsC = append(q.results.componentsC, c)
+			q.results.componentsD = append(q.results.componentsD, d)
+			q.results.componentsE = append(q.results.componentsE, e)
+			q.results.componentsF = append(q.results.componentsF, f)
+			q.results.componentsG = append(q.results.componentsG, g)
+			q.results.componentsH = append(q.results.componentsH, h)
+			q.results.componentsI = append(q.results.componentsI, i)
+			q.results.componentsJ = append(q.results.componentsJ, j)
+			q.results.componentsK = append(q.results.componentsK, k)
+			q.results.componentsL = append(q.results.componentsL, l)
+			q.results.componentsM = append(q.results.componentsM, m)
+			q.results.entityIds = append(q.results.entityIds, entity)
+		}
+	}
+
+	return nil
+}
+
+func (q *Query14[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM, ComponentN, QueryOptions]) Exec(world *World) (err error) {
+	q.ClearResults()
+
+	archetypes := q.matchingArchetypes(world)
+	lastRun, thisRun := q.advanceChangeTick(world)
+	usesTicks := q.options.usesTicks()
+
+	for _, archetype := range archetypes {
+		fetchA := archetype.HasComponent(q.componentIdA)
+		fetchB := archetype.HasComponent(q.componentIdB)
+		fetchC := archetype.HasComponent(q.componentIdC)
+		fetchD := archetype.HasComponent(q.componentIdD)
+		fetchE := archetype.HasComponent(q.componentIdE)
+		fetchF := archetype.HasComponent(q.componentIdF)
+		fetchG := archetype.HasComponent(q.componentIdG)
+		fetchH := archetype.HasComponent(q.componentIdH)
+		fetchI := archetype.HasComponent(q.componentIdI)
+		fetchJ := archetype.HasComponent(q.componentIdJ)
+		fetchK := archetype.HasComponent(q.componentIdK)
+		fetchL := archetype.HasComponent(q.componentIdL)
+		fetchM := archetype.HasComponent(q.componentIdM)
+		fetchN := archetype.HasComponent(q.componentIdN)
+
+		for _, entity := range archetype.entities {
+			entityData := &world.entities[entity.index]
+			if usesTicks && q.options.isEntityFilteredOutByTicks(entityData, lastRun) {
+				continue
+			}
+
+			var a *ComponentA
+			if fetchA {
+				a, err = fetchComponentForQueryResult[ComponentA](q.componentIdA, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var b *ComponentB
+			if fetchB {
+				b, err = fetchComponentForQueryResult[ComponentB](q.componentIdB, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var c *ComponentC
+			if fetchC {
+				c, err = fetchComponentForQueryResult[ComponentC](q.componentIdC, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var d *ComponentD
+			if fetchD {
+				d, err = fetchComponentForQueryResult[ComponentD](q.componentIdD, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var e *ComponentE
+			if fetchE {
+				e, err = fetchComponentForQueryResult[ComponentE](q.componentIdE, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var f *ComponentF
+			if fetchF {
+				f, err = fetchComponentForQueryResult[ComponentF](q.componentIdF, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var g *ComponentG
+			if fetchG {
+				g, err = fetchComponentForQueryResult[ComponentG](q.componentIdG, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var h *ComponentH
+			if fetchH {
+				h, err = fetchComponentForQueryResult[ComponentH](q.componentIdH, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var i *ComponentI
+			if fetchI {
+				i, err = fetchComponentForQueryResult[ComponentI](q.componentIdI, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var j *ComponentJ
+			if fetchJ {
+				j, err = fetchComponentForQueryResult[ComponentJ](q.componentIdJ, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var k *ComponentK
+			if fetchK {
+				k, err = fetchComponentForQueryResult[ComponentK](q.componentIdK, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var l *ComponentL
+			if fetchL {
+				l, err = fetchComponentForQueryResult[ComponentL](q.componentIdL, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var m *ComponentM
+			if fetchM {
+				m, err = fetchComponentForQueryResult[ComponentM](q.componentIdM, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var n *ComponentN
+			if fetchN {
+				n, err = fetchComponentForQueryResult[ComponentN](q.componentIdN, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			q.results.componentsA = append(q.results.componentsA, a)
+			q.results.componentsB = append(q.results.componentsB, b)
+			q.results.componentsC = append(q.results.componentsC, c)
+			q.results.componentsD = append(q.results.componentsD, d)
+			q.results.componentsE = append(q.results.componentsE, e)
+			q.results.componentsF = append(q.results.componentsF, f)
+			q.results.componentsG = append(q.results.componentsG, g)
+			q.results.componentsH = append(q.results.componentsH, h)
+			q.results.componentsI = append(q.results.componentsI, i)
+			q.results.componentsJ = append(q.results.componentsJ, j)
+			q.results.componentsK = append(q.results.componentsK, k)
+			q.results.componentsL = append(q.results.componentsL, l)
+			q.results.componentsM = append(q.results.componentsM, m)
+			q.results.componentsN = append(q.results.componentsN, n)
+			q.results.entityIds = append(q.results.entityIds, entity)
+		}
+	}
+
+	return nil
+}
+
+func (q *Query15[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM, ComponentN, ComponentO, QueryOptions]) Exec(world *World) (err error) {
+	q.ClearResults()
+
+	archetypes := q.matchingArchetypes(world)
+	lastRun, thisRun := q.advanceChangeTick(world)
+	usesTicks := q.options.usesTicks()
+
+	for _, archetype := range archetypes {
+		fetchA := archetype.HasComponent(q.componentIdA)
+		fetchB := archetype.HasComponent(q.componentIdB)
+		fetchC := archetype.HasComponent(q.componentIdC)
+		fetchD := archetype.HasComponent(q.componentIdD)
+		fetchE := archetype.HasComponent(q.componentIdE)
+		fetchF := archetype.HasComponent(q.componentIdF)
+		fetchG := archetype.HasComponent(q.componentIdG)
+		fetchH := archetype.HasComponent(q.componentIdH)
+		fetchI := archetype.HasComponent(q.componentIdI)
+		fetchJ := archetype.HasComponent(q.componentIdJ)
+		fetchK := archetype.HasComponent(q.componentIdK)
+		fetchL := archetype.HasComponent(q.componentIdL)
+		fetchM := archetype.HasComponent(q.componentIdM)
+		fetchN := archetype.HasComponent(q.componentIdN)
+		fetchO := archetype.HasComponent(q.componentIdO)
+
+		for _, entity := range archetype.entities {
+			entityData := &world.entities[entity.index]
+			if usesTicks && q.options.isEntityFilteredOutByTicks(entityData, lastRun) {
+				continue
+			}
+
+			var a *ComponentA
+			if fetchA {
+				a, err = fetchComponentForQueryResult[ComponentA](q.componentIdA, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var b *ComponentB
+			if fetchB {
+				b, err = fetchComponentForQueryResult[ComponentB](q.componentIdB, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var c *ComponentC
+			if fetchC {
+				c, err = fetchComponentForQueryResult[ComponentC](q.componentIdC, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var d *ComponentD
+			if fetchD {
+				d, err = fetchComponentForQueryResult[ComponentD](q.componentIdD, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var e *ComponentE
+			if fetchE {
+				e, err = fetchComponentForQueryResult[ComponentE](q.componentIdE, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var f *ComponentF
+			if fetchF {
+				f, err = fetchComponentForQueryResult[ComponentF](q.componentIdF, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var g *ComponentG
+			if fetchG {
+				g, err = fetchComponentForQueryResult[ComponentG](q.componentIdG, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var h *ComponentH
+			if fetchH {
+				h, err = fetchComponentForQueryResult[ComponentH](q.componentIdH, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var i *ComponentI
+			if fetchI {
+				i, err = fetchComponentForQueryResult[ComponentI](q.componentIdI, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var j *ComponentJ
+			if fetchJ {
+				j, err = fetchComponentForQueryResult[ComponentJ](q.componentIdJ, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var k *ComponentK
+			if fetchK {
+				k, err = fetchComponentForQueryResult[ComponentK](q.componentIdK, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var l *ComponentL
+			if fetchL {
+				l, err = fetchComponentForQueryResult[ComponentL](q.componentIdL, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var m *ComponentM
+			if fetchM {
+				m, err = fetchComponentForQueryResult[ComponentM](q.componentIdM, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var n *ComponentN
+			if fetchN {
+				n, err = fetchComponentForQueryResult[ComponentN](q.componentIdN, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var o *ComponentO
+			if fetchO {
+				o, err = fetchComponentForQueryResult[ComponentO](q.componentIdO, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			q.results.componentsA = append(q.results.componentsA, a)
+			q.results.componentsB = append(q.results.componentsB, b)
+			q.results.componentsC = append(q.results.componentsC, c)
+			q.results.componentsD = append(q.results.componentsD, d)
+			q.results.componentsE = append(q.results.componentsE, e)
+			q.results.componentsF = append(q.results.componentsF, f)
+			q.results.componentsG = append(q.results.componentsG, g)
+			q.results.componentsH = append(q.results.componentsH, h)
+			q.results.componentsI = append(q.results.componentsI, i)
+			q.results.componentsJ = append(q.results.componentsJ, j)
+			q.results.componentsK = append(q.results.componentsK, k)
+			q.results.componentsL = append(q.results.componentsL, l)
+			q.results.componentsM = append(q.results.componentsM, m)
+			q.results.componentsN = append(q.results.componentsN, n)
+			q.results.componentsO = append(q.results.componentsO, o)
+			q.results.entityIds = append(q.results.entityIds, entity)
+		}
+	}
+
+	return nil
+}
+
+func (q *Query16[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM, ComponentN, ComponentO, ComponentP, QueryOptions]) Exec(world *World) (err error) {
+	q.ClearResults()
+
+	archetypes := q.matchingArchetypes(world)
+	lastRun, thisRun := q.advanceChangeTick(world)
+	usesTicks := q.options.usesTicks()
+
+	for _, archetype := range archetypes {
+		fetchA := archetype.HasComponent(q.componentIdA)
+		fetchB := archetype.HasComponent(q.componentIdB)
+		fetchC := archetype.HasComponent(q.componentIdC)
+		fetchD := archetype.HasComponent(q.componentIdD)
+		fetchE := archetype.HasComponent(q.componentIdE)
+		fetchF := archetype.HasComponent(q.componentIdF)
+		fetchG := archetype.HasComponent(q.componentIdG)
+		fetchH := archetype.HasComponent(q.componentIdH)
+		fetchI := archetype.HasComponent(q.componentIdI)
+		fetchJ := archetype.HasComponent(q.componentIdJ)
+		fetchK := archetype.HasComponent(q.componentIdK)
+		fetchL := archetype.HasComponent(q.componentIdL)
+		fetchM := archetype.HasComponent(q.componentIdM)
+		fetchN := archetype.HasComponent(q.componentIdN)
+		fetchO := archetype.HasComponent(q.componentIdO)
+		fetchP := archetype.HasComponent(q.componentIdP)
+
+		for _, entity := range archetype.entities {
+			entityData := &world.entities[entity.index]
+			if usesTicks && q.options.isEntityFilteredOutByTicks(entityData, lastRun) {
+				continue
+			}
+
+			var a *ComponentA
+			if fetchA {
+				a, err = fetchComponentForQueryResult[ComponentA](q.componentIdA, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var b *ComponentB
+			if fetchB {
+				b, err = fetchComponentForQueryResult[ComponentB](q.componentIdB, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var c *ComponentC
+			if fetchC {
+				c, err = fetchComponentForQueryResult[ComponentC](q.componentIdC, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var d *ComponentD
+			if fetchD {
+				d, err = fetchComponentForQueryResult[ComponentD](q.componentIdD, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var e *ComponentE
+			if fetchE {
+				e, err = fetchComponentForQueryResult[ComponentE](q.componentIdE, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var f *ComponentF
+			if fetchF {
+				f, err = fetchComponentForQueryResult[ComponentF](q.componentIdF, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var g *ComponentG
+			if fetchG {
+				g, err = fetchComponentForQueryResult[ComponentG](q.componentIdG, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var h *ComponentH
+			if fetchH {
+				h, err = fetchComponentForQueryResult[ComponentH](q.componentIdH, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var i *ComponentI
+			if fetchI {
+				i, err = fetchComponentForQueryResult[ComponentI](q.componentIdI, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var j *ComponentJ
+			if fetchJ {
+				j, err = fetchComponentForQueryResult[ComponentJ](q.componentIdJ, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var k *ComponentK
+			if fetchK {
+				k, err = fetchComponentForQueryResult[ComponentK](q.componentIdK, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var l *ComponentL
+			if fetchL {
+				l, err = fetchComponentForQueryResult[ComponentL](q.componentIdL, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var m *ComponentM
+			if fetchM {
+				m, err = fetchComponentForQueryResult[ComponentM](q.componentIdM, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var n *ComponentN
+			if fetchN {
+				n, err = fetchComponentForQueryResult[ComponentN](q.componentIdN, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var o *ComponentO
+			if fetchO {
+				o, err = fetchComponentForQueryResult[ComponentO](q.componentIdO, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			var p *ComponentP
+			if fetchP {
+				p, err = fetchComponentForQueryResult[ComponentP](q.componentIdP, entityData.row, archetype, &q.options, thisRun)
+				if err != nil {
+					return err
+				}
+			}
+
+			q.results.componentsA = append(q.results.componentsA, a)
+			q.results.componentsB = append(q.results.componentsB, b)
+			q.results.componentsC = append(q.results.componentsC, c)
+			q.results.componentsD = append(q.results.componentsD, d)
+			q.results.componentsE = append(q.results.componentsE, e)
+			q.results.componentsF = append(q.results.componentsF, f)
+			q.results.componentsG = append(q.results.componentsG, g)
+			q.results.componentsH = append(q.results.componentsH, h)
+			q.results.componentsI = append(q.results.componentsI, i)
+			q.results.componentsJ = append(q.results.componentsJ, j)
+			q.results.componentsK = append(q.results.componentsK, k)
+			q.results.componentsL = append(q.results.componentsL, l)
+			q.results.componentsM = append(q.results.componentsM, m)
+			q.results.componentsN = append(q.results.componentsN, n)
+			q.results.componentsO = append(q.results.componentsO, o)
+			q.results.componentsP = append(q.results.componentsP, p)
+			q.results.entityIds = append(q.results.entityIds, entity)
+		}
+	}
+
+	return nil
+}
+
+func (q *Query1[A, QueryOptions]) Prepare(world *World) (err error) {
+	q.options, err = toCombinedQueryOptions[QueryOptions](world)
+	q.archetypeCache = archetypeCache{}
+	q.componentIdA = ComponentIdFor[A](world)
+	q.components = []ComponentId{
+		q.componentIdA,
+	}
+	q.options.optimize(q.components)
+	return err
+}
+
+func (q *Query2[A, B, QueryOptions]) Prepare(world *World) (err error) {
+	q.options, err = toCombinedQueryOptions[QueryOptions](world)
+	q.archetypeCache = archetypeCache{}
+	q.componentIdA = ComponentIdFor[A](world)
+	q.componentIdB = ComponentIdFor[B](world)
+	q.components = []ComponentId{
+		q.componentIdA,
+		q.componentIdB,
+	}
+	q.options.optimize(q.components)
+	return err
+}
+
+func (q *Query3[A, B, C, QueryOptions]) Prepare(world *World) (err error) {
+	q.options, err = toCombinedQueryOptions[QueryOptions](world)
+	q.archetypeCache = archetypeCache{}
+	q.componentIdA = ComponentIdFor[A](world)
+	q.componentIdB = ComponentIdFor[B](world)
+	q.componentIdC = ComponentIdFor[C](world)
+	q.components = []ComponentId{
+		q.componentIdA,
+		q.componentIdB,
+		q.componentIdC,
+	}
+	q.options.optimize(q.components)
+	return err
+}
+
+func (q *Query4[A, B, C, D, QueryOptions]) Prepare(world *World) (err error) {
+	q.options, err = toCombinedQueryOptions[QueryOptions](world)
+	q.archetypeCache = archetypeCache{}
+	q.componentIdA = ComponentIdFor[A](world)
+	q.componentIdB = ComponentIdFor[B](world)
+	q.componentIdC = ComponentIdFor[C](world)
+	q.componentIdD = ComponentIdFor[D](world)
+	q.components = []ComponentId{
+		q.componentIdA,
+		q.componentIdB,
+		q.componentIdC,
+		q.componentIdD,
+	}
+	q.options.optimize(q.components)
+	return err
+}
+
+func (q *Query5[A, B, C, D, E, QueryOptions]) Prepare(world *World) (err error) {
+	q.options, err = toCombinedQueryOptions[QueryOptions](world)
+	q.archetypeCache = archetypeCache{}
+	q.componentIdA = ComponentIdFor[A](world)
+	q.componentIdB = ComponentIdFor[B](world)
+	q.componentIdC = ComponentIdFor[C](world)
+	q.componentIdD = ComponentIdFor[D](world)
+	q.componentIdE = ComponentIdFor[E](world)
+	q.components = []ComponentId{
+		q.componentIdA,
+		q.componentIdB,
+		q.componentIdC,
+		q.componentIdD,
+		q.componentIdE,
+	}
+	q.options.optimize(q.components)
+	return err
+}
+
+func (q *Query6[A, B, C, D, E, F, QueryOptions]) Prepare(world *World) (err error) {
+	q.options, err = toCombinedQueryOptions[QueryOptions](world)
+	q.archetypeCache = archetypeCache{}
+	q.componentIdA = ComponentIdFor[A](world)
+	q.componentIdB = ComponentIdFor[B](world)
+	q.componentIdC = ComponentIdFor[C](world)
+	q.componentIdD = ComponentIdFor[D](world)
+	q.componentIdE = ComponentIdFor[E](world)
+	q.componentIdF = ComponentIdFor[F](world)
+	q.components = []ComponentId{
+		q.componentIdA,
+		q.componentIdB,
+		q.componentIdC,
+		q.componentIdD,
+		q.componentIdE,
+		q.componentIdF,
+	}
+	q.options.optimize(q.components)
+	return err
+}
+
+func (q *Query7[A, B, C, D, E, F, G, QueryOptions]) Prepare(world *World) (err error) {
+	q.options, err = toCombinedQueryOptions[QueryOptions](world)
+	q.archetypeCache = archetypeCache{}
+	q.componentIdA = ComponentIdFor[A](world)
+	q.componentIdB = ComponentIdFor[B](world)
+	q.componentIdC = ComponentIdFor[C](world)
+	q.componentIdD = ComponentIdFor[D](world)
+	q.componentIdE = ComponentIdFor[E](world)
+	q.componentIdF = ComponentIdFor[F](world)
+	q.componentIdG = ComponentIdFor[G](world)
+	q.components = []ComponentId{
+		q.componentIdA,
+		q.componentIdB,
+		q.componentIdC,
+		q.componentIdD,
+		q.componentIdE,
+		q.componentIdF,
+		q.componentIdG,
+	}
+	q.options.optimize(q.components)
+	return err
+}
+
+func (q *Query8[A, B, C, D, E, F, G, H, QueryOptions]) Prepare(world *World) (err error) {
+	q.options, err = toCombinedQueryOptions[QueryOptions](world)
+	q.archetypeCache = archetypeCache{}
+	q.componentIdA = ComponentIdFor[A](world)
+	q.componentIdB = ComponentIdFor[B](world)
+	q.componentIdC = ComponentIdFor[C](world)
+	q.componentIdD = ComponentIdFor[D](world)
+	q.componentIdE = ComponentIdFor[E](world)
+	q.componentIdF = ComponentIdFor[F](world)
+	q.componentIdG = ComponentIdFor[G](world)
+	q.componentIdH = ComponentIdFor[H](world)
+	q.components = []ComponentId{
+		q.componentIdA,
+		q.componentIdB,
+		q.componentIdC,
+		q.componentIdD,
+		q.componentIdE,
+		q.componentIdF,
+		q.componentIdG,
+		q.componentIdH,
+	}
+	q.options.optimize(q.components)
+	return err
+}
+
+func (q *Query9[A, B, C, D, E, F, G, H, I, QueryOptions]) Prepare(world *World) (err error) {
+	q.options, err = toCombinedQueryOptions[QueryOptions](world)
+	q.archetypeCache = archetypeCache{}
+	q.componentIdA = ComponentIdFor[A](world)
+	q.componentIdB = ComponentIdFor[B](world)
+	q.componentIdC = ComponentIdFor[C](world)
+	q.componentIdD = ComponentIdFor[D](world)
+	q.componentIdE = ComponentIdFor[E](world)
+	q.componentIdF = ComponentIdFor[F](world)
+	q.componentIdG = ComponentIdFor[G](world)
+	q.componentIdH = ComponentIdFor[H](world)
+	q.componentIdI = ComponentIdFor[I](world)
+	q.components = []ComponentId{
+		q.componentIdA,
+		q.componentIdB,
+		q.componentIdC,
+		q.componentIdD,
+		q.componentIdE,
+		q.componentIdF,
+		q.componentIdG,
+		q.componentIdH,
+		q.componentIdI,
+	}
+	q.options.optimize(q.components)
+	return err
+}
+
+func (q *Query10[A, B, C, D, E, F, G, H, I, J, QueryOptions]) Prepare(world *World) (err error) {
+	q.options, err = toCombinedQueryOptions[QueryOptions](world)
+	q.archetypeCache = archetypeCache{}
+	q.componentIdA = ComponentIdFor[A](world)
+	q.componentIdB = ComponentIdFor[B](world)
+	q.componentIdC = ComponentIdFor[C](world)
+	q.componentIdD = ComponentIdFor[D](world)
+	q.componentIdE = ComponentIdFor[E](world)
+	q.componentIdF = ComponentIdFor[F](world)
+	q.componentIdG = ComponentIdFor[G](world)
+	q.componentIdH = ComponentIdFor[H](world)
+	q.componentIdI = ComponentIdFor[I](world)
+	q.componentIdJ = ComponentIdFor[J](world)
+	q.components = []ComponentId{
+		q.componentIdA,
+		q.componentIdB,
+		q.componentIdC,
+		q.componentIdD,
+		q.componentIdE,
+		q.componentIdF,
+		q.componentIdG,
+		q.componentIdH,
+		q.componentIdI,
+		q.componentIdJ,
+	}
+	q.options.optimize(q.components)
+	return err
+}
+
+func (q *Query11[A, B, C, D, E, F, G, H, I, J, K, QueryOptions]) Prepare(world *World) (err error) {
+	q.options, err = toCombinedQueryOptions[QueryOptions](world)
+	q.archetypeCache = archetypeCache{}
+	q.componentIdA = ComponentIdFor[A](world)
+	q.componentIdB = ComponentIdFor[B](world)
+	q.componentIdC = ComponentIdFor[C](world)
+	q.componentIdD = ComponentIdFor[D](world)
+	q.componentIdE = ComponentIdFor[E](world)
+	q.componentIdF = ComponentIdFor[F](world)
+	q.componentIdG = ComponentIdFor[G](world)
+	q.componentIdH = ComponentIdFor[H](world)
+	q.componentIdI = ComponentIdFor[I](world)
+	q.componentIdJ = ComponentIdFor[J](world)
+	q.componentIdK = ComponentIdFor[K](world)
+	q.components = []ComponentId{
+		q.componentIdA,
+		q.componentIdB,
+		q.componentIdC,
+		q.componentIdD,
+		q.componentIdE,
+		q.componentIdF,
+		q.componentIdG,
+		q.componentIdH,
+		q.componentIdI,
+		q.componentIdJ,
+		q.componentIdK,
+	}
+	q.options.optimize(q.components)
+	return err
+}
+
+func (q *Query12[A, B, C, D, E, F, G, H, I, J, K, L, QueryOptions]) Prepare(world *World) (err error) {
+	q.options, err = toCombinedQueryOptions[QueryOptions](world)
+	q.archetypeCache = archetypeCache{}
+	q.componentIdA = ComponentIdFor[A](world)
+	q.componentIdB = ComponentIdFor[B](world)
+	q.componentIdC = ComponentIdFor[C](world)
+	q.componentIdD = ComponentIdFor[D](world)
+	q.componentIdE = ComponentIdFor[E](world)
+	q.componentIdF = ComponentIdFor[F](world)
+	q.componentIdG = ComponentIdFor[G](world)
+	q.componentIdH = ComponentIdFor[H](world)
+	q.componentIdI = ComponentIdFor[I](world)
+	q.componentIdJ = ComponentIdFor[J](world)
+	q.componentIdK = ComponentIdFor[K](world)
+	q.componentIdL = ComponentIdFor[L](world)
+	q.components = []ComponentId{
+		q.componentIdA,
+		q.componentIdB,
+		q.componentIdC,
+		q.componentIdD,
+		q.componentIdE,
+		q.componentIdF,
+		q.componentIdG,
+		q.componentIdH,
+		q.componentIdI,
+		q.componentIdJ,
+		q.componentIdK,
+		q.componentIdL,
+	}
+	q.options.optimize(q.components)
+	return err
+}
+
+func (q *Query13[A, B, C, D, E, F, G, H, I, J, K, L, M, QueryOptions]) Prepare(world *World) (err error) {
+	q.options, err = toCombinedQueryOptions[QueryOptions](world)
+	q.archetypeCache = archetypeCache{}
+	q.componentIdA = ComponentIdFor[A](world)
+	q.componentIdB = ComponentIdFor[B](world)
+	q.componentIdC = ComponentIdFor[C](world)
+	q.componentIdD = ComponentIdFor[D](world)
+	q.componentIdE = ComponentIdFor[E](world)
+	q.componentIdF = ComponentIdFor[F](world)
+	q.componentIdG = ComponentIdFor[G](world)
+	q.componentIdH = ComponentIdFor[H](world)
+	q.componentIdI = ComponentIdFor[I](world)
+	q.componentIdJ = ComponentIdFor[J](world)
+	q.componentIdK = ComponentIdFor[K](world)
+	q.componentIdL = ComponentIdFor[L](world)
+	q.componentIdM = ComponentIdFor[M](world)
+	q.components = []ComponentId{
+		q.componentIdA,
+		q.componentIdB,
+		q.componentIdC,
+		q.componentIdD,
+		q.componentIdE,
+		q.componentIdF,
+		q.componentIdG,
+		q.componentIdH,
+		q.componentIdI,
+		q.componentIdJ,
+		q.componentIdK,
+		q.componentIdL,
+		q.componentIdM,
+	}
+	q.options.optimize(q.components)
+	return err
+}
+
+func (q *Query14[A, B, C, D, E, F, G, H, I, J, K, L, M, N, QueryOptions]) Prepare(world *World) (err error) {
+	q.options, err = toCombinedQueryOptions[QueryOptions](world)
+	q.archetypeCache = archetypeCache{}
+	q.componentIdA = ComponentIdFor[A](world)
+	q.componentIdB = ComponentIdFor[B](world)
+	q.componentIdC = ComponentIdFor[C](world)
+	q.componentIdD = ComponentIdFor[D](world)
+	q.componentIdE = ComponentIdFor[E](world)
+	q.componentIdF = ComponentIdFor[F](world)
+	q.componentIdG = ComponentIdFor[G](world)
+	q.componentIdH = ComponentIdFor[H](world)
+	q.componentIdI = ComponentIdFor[I](world)
+	q.componentIdJ = ComponentIdFor[J](world)
+	q.componentIdK = ComponentIdFor[K](world)
+	q.componentIdL = ComponentIdFor[L](world)
+	q.componentIdM = ComponentIdFor[M](world)
+	q.componentIdN = ComponentIdFor[N](world)
+	q.components = []ComponentId{
+		q.componentIdA,
+		q.componentIdB,
+		q.componentIdC,
+		q.componentIdD,
+		q.componentIdE,
+		q.componentIdF,
+		q.componentIdG,
+		q.componentIdH,
+		q.componentIdI,
+		q.componentIdJ,
+		q.componentIdK,
+		q.componentIdL,
+		q.componentIdM,
+		q.componentIdN,
+	}
+	q.options.optimize(q.components)
+	return err
+}
+
+func (q *Query15[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, QueryOptions]) Prepare(world *World) (err error) {
+	q.options, err = toCombinedQueryOptions[QueryOptions](world)
+	q.archetypeCache = archetypeCache{}
+	q.componentIdA = ComponentIdFor[A](world)
+	q.componentIdB = ComponentIdFor[B](world)
+	q.componentIdC = ComponentIdFor[C](world)
+	q.componentIdD = ComponentIdFor[D](world)
+	q.componentIdE = ComponentIdFor[E](world)
+	q.componentIdF = ComponentIdFor[F](world)
+	q.componentIdG = ComponentIdFor[G](world)
+	q.componentIdH = ComponentIdFor[H](world)
+	q.componentIdI = ComponentIdFor[I](world)
+	q.componentIdJ = ComponentIdFor[J](world)
+	q.componentIdK = ComponentIdFor[K](world)
+	q.componentIdL = ComponentIdFor[L](world)
+	q.componentIdM = ComponentIdFor[M](world)
+	q.componentIdN = ComponentIdFor[N](world)
+	q.componentIdO = ComponentIdFor[O](world)
+	q.components = []ComponentId{
+		q.componentIdA,
+		q.componentIdB,
+		q.componentIdC,
+		q.componentIdD,
+		q.componentIdE,
+		q.componentIdF,
+		q.componentIdG,
+		q.componentIdH,
+		q.componentIdI,
+		q.componentIdJ,
+		q.componentIdK,
+		q.componentIdL,
+		q.componentIdM,
+		q.componentIdN,
+		q.componentIdO,
+	}
+	q.options.optimize(q.components)
+	return err
+}
+
+func (q *Query16[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, QueryOptions]) Prepare(world *World) (err error) {
+	q.options, err = toCombinedQueryOptions[QueryOptions](world)
+	q.archetypeCache = archetypeCache{}
+	q.componentIdA = ComponentIdFor[A](world)
+	q.componentIdB = ComponentIdFor[B](world)
+	q.componentIdC = ComponentIdFor[C](world)
+	q.componentIdD = ComponentIdFor[D](world)
+	q.componentIdE = ComponentIdFor[E](world)
+	q.componentIdF = ComponentIdFor[F](world)
+	q.componentIdG = ComponentIdFor[G](world)
+	q.componentIdH = ComponentIdFor[H](world)
+	q.componentIdI = ComponentIdFor[I](world)
+	q.componentIdJ = ComponentIdFor[J](world)
+	q.componentIdK = ComponentIdFor[K](world)
+	q.componentIdL = ComponentIdFor[L](world)
+	q.componentIdM = ComponentIdFor[M](world)
+	q.componentIdN = ComponentIdFor[N](world)
+	q.componentIdO = ComponentIdFor[O](world)
+	q.componentIdP = ComponentIdFor[P](world)
+	q.components = []ComponentId{
+		q.componentIdA,
+		q.componentIdB,
+		q.componentIdC,
+		q.componentIdD,
+		q.componentIdE,
+		q.componentIdF,
+		q.componentIdG,
+		q.componentIdH,
+		q.componentIdI,
+		q.componentIdJ,
+		q.componentIdK,
+		q.componentIdL,
+		q.componentIdM,
+		q.componentIdN,
+		q.componentIdO,
+		q.componentIdP,
+	}
+	q.options.optimize(q.components)
+	return err
+}
+
+func (q *Query1[ComponentA, QueryOptions]) Result() *Query1Result[ComponentA] {
+	return &q.results
+}
+
+func (q *Query2[ComponentA, ComponentB, QueryOptions]) Result() *Query2Result[ComponentA, ComponentB] {
+	return &q.results
+}
+
+func (q *Query3[ComponentA, ComponentB, ComponentC, QueryOptions]) Result() *Query3Result[ComponentA, ComponentB, ComponentC] {
+	return &q.results
+}
+
+func (q *Query4[ComponentA, ComponentB, ComponentC, ComponentD, QueryOptions]) Result() *Query4Result[ComponentA, ComponentB, ComponentC, ComponentD] {
+	return &q.results
+}
+
+func (q *Query5[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, QueryOptions]) Result() *Query5Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE] {
+	return &q.results
+}
+
+func (q *Query6[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, QueryOptions]) Result() *Query6Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF] {
+	return &q.results
+}
+
+func (q *Query7[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, QueryOptions]) Result() *Query7Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG] {
+	return &q.results
+}
+
+func (q *Query8[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, QueryOptions]) Result() *Query8Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH] {
+	return &q.results
+}
+
+func (q *Query9[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, QueryOptions]) Result() *Query9Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI] {
+	return &q.results
+}
+
+func (q *Query10[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, QueryOptions]) Result() *Query10Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ] {
+	return &q.results
+}
+
+func (q *Query11[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, QueryOptions]) Result() *Query11Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK] {
+	return &q.results
+}
+
+func (q *Query12[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, QueryOptions]) Result() *Query12Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL] {
+	return &q.results
+}
+
+func (q *Query13[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM, QueryOptions]) Result() *Query13Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM] {
+	return &q.results
+}
+
+func (q *Query14[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM, ComponentN, QueryOptions]) Result() *Query14Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM, ComponentN] {
+	return &q.results
+}
+
+func (q *Query15[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM, ComponentN, ComponentO, QueryOptions]) Result() *Query15Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM, ComponentN, ComponentO] {
+	return &q.results
+}
+
+func (q *Query16[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM, ComponentN, ComponentO, ComponentP, QueryOptions]) Result() *Query16Result[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM, ComponentN, ComponentO, ComponentP] {
+	return &q.results
+}
+
+func (q *Query1[ComponentA, QueryOptions]) ClearResults() {
+	q.results.Clear()
+}
+
+func (q *Query2[ComponentA, ComponentB, QueryOptions]) ClearResults() {
+	q.results.Clear()
+}
+
+func (q *Query3[ComponentA, ComponentB, ComponentC, QueryOptions]) ClearResults() {
+	q.results.Clear()
+}
+
+func (q *Query4[ComponentA, ComponentB, ComponentC, ComponentD, QueryOptions]) ClearResults() {
+	q.results.Clear()
+}
+
+func (q *Query5[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, QueryOptions]) ClearResults() {
+	q.results.Clear()
+}
+
+func (q *Query6[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, QueryOptions]) ClearResults() {
+	q.results.Clear()
+}
+
+func (q *Query7[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, QueryOptions]) ClearResults() {
+	q.results.Clear()
+}
+
+func (q *Query8[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, QueryOptions]) ClearResults() {
+	q.results.Clear()
+}
+
+func (q *Query9[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, QueryOptions]) ClearResults() {
+	q.results.Clear()
+}
+
+func (q *Query10[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, QueryOptions]) ClearResults() {
+	q.results.Clear()
+}
+
+func (q *Query11[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, QueryOptions]) ClearResults() {
+	q.results.Clear()
+}
+
+func (q *Query12[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, QueryOptions]) ClearResults() {
+	q.results.Clear()
+}
+
+func (q *Query13[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM, QueryOptions]) ClearResults() {
+	q.results.Clear()
+}
+
+func (q *Query14[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM, ComponentN, QueryOptions]) ClearResults() {
+	q.results.Clear()
+}
+
+func (q *Query15[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM, ComponentN, ComponentO, QueryOptions]) ClearResults() {
+	q.results.Clear()
+}
+
+func (q *Query16[ComponentA, ComponentB, ComponentC, ComponentD, ComponentE, ComponentF, ComponentG, ComponentH, ComponentI, ComponentJ, ComponentK, ComponentL, ComponentM, ComponentN, ComponentO, ComponentP, QueryOptions]) ClearResults() {
+	q.results.Clear()
+}
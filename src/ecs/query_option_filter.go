@@ -14,6 +14,8 @@ const (
 	filterTypeAnd
 	filterTypeOr
 	filterTypeNone
+	filterTypeAdded
+	filterTypeChanged
 )
 
 type QueryParamFilter interface {
@@ -27,6 +29,19 @@ type Without[A IComponent] struct{}
 type And[A, B QueryParamFilter] struct{}
 type Or[A, B QueryParamFilter] struct{}
 
+// Added makes the results only include entities of which component A was added since the last time that the
+// query was executed. For queries that are used as a system param, this is the last time that the system ran.
+type Added[A IComponent] struct{}
+
+// Changed makes the results only include entities of which component A was added or changed since the last time
+// that the query was executed. For queries that are used as a system param, this is the last time that the
+// system ran.
+//
+// Components are marked as changed when they are fetched by a query in which they are not read-only, when they
+// are retrieved with Get1, Get2 (and so on), and when they are overwritten with InsertOrOverwrite. Mark
+// components as read-only in queries that do not alter them, to prevent them from being marked as changed.
+type Changed[A IComponent] struct{}
+
 func (filter NoFilter) getComponents(world *World) []ComponentId {
 	return []ComponentId{}
 }
@@ -47,6 +62,14 @@ func (filter Without[A]) getComponents(world *World) []ComponentId {
 	return []ComponentId{ComponentIdFor[A](world)}
 }
 
+func (filter Added[A]) getComponents(world *World) []ComponentId {
+	return []ComponentId{ComponentIdFor[A](world)}
+}
+
+func (filter Changed[A]) getComponents(world *World) []ComponentId {
+	return []ComponentId{ComponentIdFor[A](world)}
+}
+
 func (filter NoFilter) getFilterType() filterType {
 	return filterTypeNone
 }
@@ -67,6 +90,14 @@ func (filter Or[A, B]) getFilterType() filterType {
 	return filterTypeOr
 }
 
+func (filter Added[A]) getFilterType() filterType {
+	return filterTypeAdded
+}
+
+func (filter Changed[A]) getFilterType() filterType {
+	return filterTypeChanged
+}
+
 func (filter NoFilter) getNestedFilters() (a QueryParamFilter, b QueryParamFilter, err error) {
 	return nil, nil, errors.New("nested filters not supported for this type")
 }
@@ -80,6 +111,14 @@ func (filter Without[A]) getNestedFilters() (a QueryParamFilter, b QueryParamFil
 	return nil, nil, errors.New("nested filters not supported for this type")
 }
 
+func (filter Added[A]) getNestedFilters() (a QueryParamFilter, b QueryParamFilter, err error) {
+	return nil, nil, errors.New("nested filters not supported for this type")
+}
+
+func (filter Changed[A]) getNestedFilters() (a QueryParamFilter, b QueryParamFilter, err error) {
+	return nil, nil, errors.New("nested filters not supported for this type")
+}
+
 func (filter And[A, B]) getNestedFilters() (a QueryParamFilter, b QueryParamFilter, err error) {
 	a, err = utils.ToConcrete[A]()
 	if err != nil {
@@ -120,13 +159,26 @@ func (filter And[A, B]) GetCombinedQueryOptions(world *World) (CombinedQueryOpti
 func (filter Or[A, B]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
 	return toCombinedQueryOptions[QueryOptions[Or[A, B], NoOptional, NoReadOnly, NotLazy, DefaultWorld]](world)
 }
+func (filter Added[A]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[Added[A], NoOptional, NoReadOnly, NotLazy, DefaultWorld]](world)
+}
+func (filter Changed[A]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[Changed[A], NoOptional, NoReadOnly, NotLazy, DefaultWorld]](world)
+}
 
 type QueryFilter interface {
-	// EntityMeetsCriteria returns false is the entity is filtered out
+	// EntityMeetsCriteria returns false is the entity is filtered out. Change ticks are not taken in to account.
 	EntityMeetsCriteria(*EntityData) bool
 
 	// EntityMeetsCriteria returns false is the archetype is filtered out
 	ArchetypeMeetsCriteria(*Archetype) bool
+
+	// entityTicksMeetCriteria returns false if the entity is filtered out, taking in to account when its
+	// components were added or changed. lastRun is the change tick of the last time that the query was executed.
+	entityTicksMeetCriteria(entityData *EntityData, lastRun uint) bool
+
+	// usesTicks returns wether the filter, or any of its nested filters, filters on change ticks.
+	usesTicks() bool
 }
 type queryFilterAnd struct {
 	a QueryFilter
@@ -142,6 +194,12 @@ type queryFilterWith struct {
 type queryFilterWithout struct {
 	c []ComponentId
 }
+type queryFilterAdded struct {
+	c []ComponentId
+}
+type queryFilterChanged struct {
+	c []ComponentId
+}
 
 func (filter *queryFilterAnd) EntityMeetsCriteria(e *EntityData) bool {
 	return filter.a.EntityMeetsCriteria(e) && filter.b.EntityMeetsCriteria(e)
@@ -198,3 +256,105 @@ func (filter *queryFilterWithout) ArchetypeMeetsCriteria(archetype *Archetype) b
 
 	return true
 }
+
+func (filter *queryFilterAdded) EntityMeetsCriteria(e *EntityData) bool {
+	for _, c := range filter.c {
+		if !e.hasComponent(c) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (filter *queryFilterChanged) EntityMeetsCriteria(e *EntityData) bool {
+	for _, c := range filter.c {
+		if !e.hasComponent(c) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (filter *queryFilterAdded) ArchetypeMeetsCriteria(archetype *Archetype) bool {
+	for _, c := range filter.c {
+		if !archetype.HasComponent(c) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (filter *queryFilterChanged) ArchetypeMeetsCriteria(archetype *Archetype) bool {
+	for _, c := range filter.c {
+		if !archetype.HasComponent(c) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (filter *queryFilterAnd) entityTicksMeetCriteria(e *EntityData, lastRun uint) bool {
+	return filter.a.entityTicksMeetCriteria(e, lastRun) && filter.b.entityTicksMeetCriteria(e, lastRun)
+}
+
+func (filter *queryFilterOr) entityTicksMeetCriteria(e *EntityData, lastRun uint) bool {
+	return filter.a.entityTicksMeetCriteria(e, lastRun) || filter.b.entityTicksMeetCriteria(e, lastRun)
+}
+
+func (filter *queryFilterWith) entityTicksMeetCriteria(e *EntityData, _ uint) bool {
+	return filter.EntityMeetsCriteria(e)
+}
+
+func (filter *queryFilterWithout) entityTicksMeetCriteria(e *EntityData, _ uint) bool {
+	return filter.EntityMeetsCriteria(e)
+}
+
+func (filter *queryFilterAdded) entityTicksMeetCriteria(e *EntityData, lastRun uint) bool {
+	for _, c := range filter.c {
+		storage, ok := e.archetype.components[c]
+		if !ok || storage.ticks[e.row].added <= lastRun {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (filter *queryFilterChanged) entityTicksMeetCriteria(e *EntityData, lastRun uint) bool {
+	for _, c := range filter.c {
+		storage, ok := e.archetype.components[c]
+		if !ok || storage.ticks[e.row].changed <= lastRun {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (filter *queryFilterAnd) usesTicks() bool {
+	return filter.a.usesTicks() || filter.b.usesTicks()
+}
+
+func (filter *queryFilterOr) usesTicks() bool {
+	return filter.a.usesTicks() || filter.b.usesTicks()
+}
+
+func (filter *queryFilterWith) usesTicks() bool {
+	return false
+}
+
+func (filter *queryFilterWithout) usesTicks() bool {
+	return false
+}
+
+func (filter *queryFilterAdded) usesTicks() bool {
+	return true
+}
+
+func (filter *queryFilterChanged) usesTicks() bool {
+	return true
+}
@@ -16,7 +16,7 @@ func TestQueryFilter(t *testing.T) {
 		world := NewDefaultWorld()
 		entity, err := Spawn(&world, &componentA{})
 		assert.NoError(err)
-		entityData := world.entities[entity]
+		entityData, _ := world.getEntityData(entity)
 
 		filter := queryFilterWith{c: []ComponentId{
 			ComponentIdFor[componentA](&world),
@@ -35,7 +35,7 @@ func TestQueryFilter(t *testing.T) {
 		world := NewDefaultWorld()
 		entity, err := Spawn(&world, &componentA{})
 		assert.NoError(err)
-		entityData := world.entities[entity]
+		entityData, _ := world.getEntityData(entity)
 
 		filter := queryFilterWithout{c: []ComponentId{
 			ComponentIdFor[componentA](&world),
@@ -54,7 +54,7 @@ func TestQueryFilter(t *testing.T) {
 		world := NewDefaultWorld()
 		entity, err := Spawn(&world, &componentA{})
 		assert.NoError(err)
-		entityData := world.entities[entity]
+		entityData, _ := world.getEntityData(entity)
 
 		// both are true
 		filter := queryFilterAnd{
@@ -96,7 +96,7 @@ func TestQueryFilter(t *testing.T) {
 		world := NewDefaultWorld()
 		entity, err := Spawn(&world, &componentA{}, &componentB{})
 		assert.NoError(err)
-		entityData := world.entities[entity]
+		entityData, _ := world.getEntityData(entity)
 
 		// both are true
 		filter := queryFilterOr{
@@ -132,3 +132,139 @@ func TestQueryFilter(t *testing.T) {
 		assert.False(filter.EntityMeetsCriteria(entityData))
 	})
 }
+
+func TestChangeDetection(t *testing.T) {
+	type componentA struct {
+		Component
+		value int
+	}
+	type componentB struct{ Component }
+
+	t.Run("Added only includes entities of which the component was added since the last execution", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		entityA, err := Spawn(&world, &componentA{})
+		assert.NoError(err)
+
+		query := Query0[Added[componentA]]{}
+		err = query.Prepare(&world)
+		assert.NoError(err)
+
+		err = query.Exec(&world)
+		assert.NoError(err)
+		assert.Equal([]EntityId{entityA}, query.Result().entityIds)
+
+		err = query.Exec(&world)
+		assert.NoError(err)
+		assert.Empty(query.Result().entityIds)
+
+		entityB, err := Spawn(&world, &componentB{})
+		assert.NoError(err)
+		err = Insert(&world, entityB, &componentA{})
+		assert.NoError(err)
+		err = query.Exec(&world)
+		assert.NoError(err)
+		assert.Equal([]EntityId{entityB}, query.Result().entityIds)
+
+		// moving the entity to another archetype does not make the component added
+		err = Insert(&world, entityA, &componentB{})
+		assert.NoError(err)
+		err = query.Exec(&world)
+		assert.NoError(err)
+		assert.Empty(query.Result().entityIds)
+	})
+
+	t.Run("Changed includes entities of which the component was retrieved mutably since the last execution", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		entities := []EntityId{}
+		for range 3 {
+			entity, err := Spawn(&world, &componentA{})
+			assert.NoError(err)
+			entities = append(entities, entity)
+		}
+
+		query := Query1[componentA, QueryOptions2[Changed[componentA], AllReadOnly]]{}
+		err := query.Prepare(&world)
+		assert.NoError(err)
+		err = query.Exec(&world)
+		assert.NoError(err)
+		assert.Equal(uint(3), query.Result().NumberOfResult())
+
+		err = query.Exec(&world)
+		assert.NoError(err)
+		assert.Equal(uint(0), query.Result().NumberOfResult())
+
+		a, err := Get1[componentA](&world, entities[1])
+		assert.NoError(err)
+		a.value = 10
+		err = InsertOrOverwrite(&world, entities[2], &componentA{value: 20})
+		assert.NoError(err)
+
+		err = query.Exec(&world)
+		assert.NoError(err)
+		assert.Equal([]EntityId{entities[1], entities[2]}, query.Result().entityIds)
+	})
+
+	t.Run("mutable query access marks components as changed for other queries but not for itself", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		_, err := Spawn(&world, &componentA{})
+		assert.NoError(err)
+		_, err = Spawn(&world, &componentA{}, &componentB{})
+		assert.NoError(err)
+
+		mutableQuery := Query1[componentA, QueryOptions2[Changed[componentA], With[componentB]]]{}
+		err = mutableQuery.Prepare(&world)
+		assert.NoError(err)
+		readOnlyQuery := Query1[componentA, QueryOptions2[Changed[componentA], AllReadOnly]]{}
+		err = readOnlyQuery.Prepare(&world)
+		assert.NoError(err)
+
+		for range 2 {
+			err = readOnlyQuery.Exec(&world)
+			assert.NoError(err)
+		}
+		assert.Equal(uint(0), readOnlyQuery.Result().NumberOfResult())
+
+		err = mutableQuery.Exec(&world)
+		assert.NoError(err)
+		assert.Equal(uint(1), mutableQuery.Result().NumberOfResult())
+		err = mutableQuery.Exec(&world)
+		assert.NoError(err)
+		assert.Equal(uint(0), mutableQuery.Result().NumberOfResult())
+
+		err = readOnlyQuery.Exec(&world)
+		assert.NoError(err)
+		assert.Equal(uint(1), readOnlyQuery.Result().NumberOfResult())
+	})
+
+	t.Run("change ticks move with components when another component is removed", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		entityA, err := Spawn(&world, &componentA{value: 1}, &componentB{})
+		assert.NoError(err)
+		entityB, err := Spawn(&world, &componentA{value: 2}, &componentB{})
+		assert.NoError(err)
+
+		query := Query1[componentA, QueryOptions2[Or[Added[componentA], Changed[componentA]], AllReadOnly]]{}
+		err = query.Prepare(&world)
+		assert.NoError(err)
+		err = query.Exec(&world)
+		assert.NoError(err)
+		assert.Equal(uint(2), query.Result().NumberOfResult())
+
+		_, err = Get1[componentA](&world, entityB)
+		assert.NoError(err)
+		err = Remove1[componentB](&world, entityA)
+		assert.NoError(err)
+
+		err = query.Exec(&world)
+		assert.NoError(err)
+		assert.Equal([]EntityId{entityB}, query.Result().entityIds)
+	})
+}
@@ -0,0 +1,332 @@
+// Code generated by internal/generate. DO NOT EDIT.
+
+package ecs
+
+// Entities will be in query result even if A is not present. If A is not present,
+// it will be nil in the query result.
+type Optional1[A IComponent] struct{}
+
+// Entities will be in query result even if any of these are not present. Components that are
+// not present will be nil in the query result.
+type Optional2[A, B IComponent] struct{}
+
+// Entities will be in query result even if any of these are not present. Components that are
+// not present will be nil in the query result.
+type Optional3[A, B, C IComponent] struct{}
+
+// Entities will be in query result even if any of these are not present. Components that are
+// not present will be nil in the query result.
+type Optional4[A, B, C, D IComponent] struct{}
+
+// Entities will be in query result even if any of these are not present. Components that are
+// not present will be nil in the query result.
+type Optional5[A, B, C, D, E IComponent] struct{}
+
+// Entities will be in query result even if any of these are not present. Components that are
+// not present will be nil in the query result.
+type Optional6[A, B, C, D, E, F IComponent] struct{}
+
+// Entities will be in query result even if any of these are not present. Components that are
+// not present will be nil in the query result.
+type Optional7[A, B, C, D, E, F, G IComponent] struct{}
+
+// Entities will be in query result even if any of these are not present. Components that are
+// not present will be nil in the query result.
+type Optional8[A, B, C, D, E, F, G, H IComponent] struct{}
+
+// Entities will be in query result even if any of these are not present. Components that are
+// not present will be nil in the query result.
+type Optional9[A, B, C, D, E, F, G, H, I IComponent] struct{}
+
+// Entities will be in query result even if any of these are not present. Components that are
+// not present will be nil in the query result.
+type Optional10[A, B, C, D, E, F, G, H, I, J IComponent] struct{}
+
+// Entities will be in query result even if any of these are not present. Components that are
+// not present will be nil in the query result.
+type Optional11[A, B, C, D, E, F, G, H, I, J, K IComponent] struct{}
+
+// Entities will be in query result even if any of these are not present. Components that are
+// not present will be nil in the query result.
+type Optional12[A, B, C, D, E, F, G, H, I, J, K, L IComponent] struct{}
+
+// Entities will be in query result even if any of these are not present. Components that are
+// not present will be nil in the query result.
+type Optional13[A, B, C, D, E, F, G, H, I, J, K, L, M IComponent] struct{}
+
+// Entities will be in query result even if any of these are not present. Components that are
+// not present will be nil in the query result.
+type Optional14[A, B, C, D, E, F, G, H, I, J, K, L, M, N IComponent] struct{}
+
+// Entities will be in query result even if any of these are not present. Components that are
+// not present will be nil in the query result.
+type Optional15[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O IComponent] struct{}
+
+// Entities will be in query result even if any of these are not present. Components that are
+// not present will be nil in the query result.
+type Optional16[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P IComponent] struct{}
+
+func (o Optional1[A]) getOptionalComponentIds(world *World) []ComponentId {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+	}
+}
+
+func (o Optional2[A, B]) getOptionalComponentIds(world *World) []ComponentId {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+	}
+}
+
+func (o Optional3[A, B, C]) getOptionalComponentIds(world *World) []ComponentId {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+	}
+}
+
+func (o Optional4[A, B, C, D]) getOptionalComponentIds(world *World) []ComponentId {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+	}
+}
+
+func (o Optional5[A, B, C, D, E]) getOptionalComponentIds(world *World) []ComponentId {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+	}
+}
+
+func (o Optional6[A, B, C, D, E, F]) getOptionalComponentIds(world *World) []ComponentId {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+	}
+}
+
+func (o Optional7[A, B, C, D, E, F, G]) getOptionalComponentIds(world *World) []ComponentId {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+	}
+}
+
+func (o Optional8[A, B, C, D, E, F, G, H]) getOptionalComponentIds(world *World) []ComponentId {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+	}
+}
+
+func (o Optional9[A, B, C, D, E, F, G, H, I]) getOptionalComponentIds(world *World) []ComponentId {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+	}
+}
+
+func (o Optional10[A, B, C, D, E, F, G, H, I, J]) getOptionalComponentIds(world *World) []ComponentId {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+		ComponentIdFor[J](world),
+	}
+}
+
+func (o Optional11[A, B, C, D, E, F, G, H, I, J, K]) getOptionalComponentIds(world *World) []ComponentId {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+		ComponentIdFor[J](world),
+		ComponentIdFor[K](world),
+	}
+}
+
+func (o Optional12[A, B, C, D, E, F, G, H, I, J, K, L]) getOptionalComponentIds(world *World) []ComponentId {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+		ComponentIdFor[J](world),
+		ComponentIdFor[K](world),
+		ComponentIdFor[L](world),
+	}
+}
+
+func (o Optional13[A, B, C, D, E, F, G, H, I, J, K, L, M]) getOptionalComponentIds(world *World) []ComponentId {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+		ComponentIdFor[J](world),
+		ComponentIdFor[K](world),
+		ComponentIdFor[L](world),
+		ComponentIdFor[M](world),
+	}
+}
+
+func (o Optional14[A, B, C, D, E, F, G, H, I, J, K, L, M, N]) getOptionalComponentIds(world *World) []ComponentId {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+		ComponentIdFor[J](world),
+		ComponentIdFor[K](world),
+		ComponentIdFor[L](world),
+		ComponentIdFor[M](world),
+		ComponentIdFor[N](world),
+	}
+}
+
+func (o Optional15[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O]) getOptionalComponentIds(world *World) []ComponentId {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+		ComponentIdFor[J](world),
+		ComponentIdFor[K](world),
+		ComponentIdFor[L](world),
+		ComponentIdFor[M](world),
+		ComponentIdFor[N](world),
+		ComponentIdFor[O](world),
+	}
+}
+
+func (o Optional16[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P]) getOptionalComponentIds(world *World) []ComponentId {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+		ComponentIdFor[J](world),
+		ComponentIdFor[K](world),
+		ComponentIdFor[L](world),
+		ComponentIdFor[M](world),
+		ComponentIdFor[N](world),
+		ComponentIdFor[O](world),
+		ComponentIdFor[P](world),
+	}
+}
+
+func (optional Optional1[A]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, Optional1[A], NoReadOnly, NotLazy, DefaultWorld]](world)
+}
+func (optional Optional2[A, B]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, Optional2[A, B], NoReadOnly, NotLazy, DefaultWorld]](world)
+}
+func (optional Optional3[A, B, C]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, Optional3[A, B, C], NoReadOnly, NotLazy, DefaultWorld]](world)
+}
+func (optional Optional4[A, B, C, D]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, Optional4[A, B, C, D], NoReadOnly, NotLazy, DefaultWorld]](world)
+}
+func (optional Optional5[A, B, C, D, E]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, Optional5[A, B, C, D, E], NoReadOnly, NotLazy, DefaultWorld]](world)
+}
+func (optional Optional6[A, B, C, D, E, F]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, Optional6[A, B, C, D, E, F], NoReadOnly, NotLazy, DefaultWorld]](world)
+}
+func (optional Optional7[A, B, C, D, E, F, G]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, Optional7[A, B, C, D, E, F, G], NoReadOnly, NotLazy, DefaultWorld]](world)
+}
+func (optional Optional8[A, B, C, D, E, F, G, H]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, Optional8[A, B, C, D, E, F, G, H], NoReadOnly, NotLazy, DefaultWorld]](world)
+}
+func (optional Optional9[A, B, C, D, E, F, G, H, I]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, Optional9[A, B, C, D, E, F, G, H, I], NoReadOnly, NotLazy, DefaultWorld]](world)
+}
+func (optional Optional10[A, B, C, D, E, F, G, H, I, J]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, Optional10[A, B, C, D, E, F, G, H, I, J], NoReadOnly, NotLazy, DefaultWorld]](world)
+}
+func (optional Optional11[A, B, C, D, E, F, G, H, I, J, K]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, Optional11[A, B, C, D, E, F, G, H, I, J, K], NoReadOnly, NotLazy, DefaultWorld]](world)
+}
+func (optional Optional12[A, B, C, D, E, F, G, H, I, J, K, L]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, Optional12[A, B, C, D, E, F, G, H, I, J, K, L], NoReadOnly, NotLazy, DefaultWorld]](world)
+}
+func (optional Optional13[A, B, C, D, E, F, G, H, I, J, K, L, M]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, Optional13[A, B, C, D, E, F, G, H, I, J, K, L, M], NoReadOnly, NotLazy, DefaultWorld]](world)
+}
+func (optional Optional14[A, B, C, D, E, F, G, H, I, J, K, L, M, N]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, Optional14[A, B, C, D, E, F, G, H, I, J, K, L, M, N], NoReadOnly, NotLazy, DefaultWorld]](world)
+}
+func (optional Optional15[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, Optional15[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O], NoReadOnly, NotLazy, DefaultWorld]](world)
+}
+func (optional Optional16[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, Optional16[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P], NoReadOnly, NotLazy, DefaultWorld]](world)
+}
@@ -0,0 +1,364 @@
+// Code generated by internal/generate. DO NOT EDIT.
+
+package ecs
+
+// Component in the query results is read-only.
+// The component in the query results will be a temporary copy.
+//
+// This allows parallelization of systems that use this query as a system parameter.
+type ReadOnly1[A IComponent] struct{}
+
+// Components in the query results are read-only.
+// The components in the query results will be temporary copies.
+//
+// This allows parallelization of systems that use this query as a system parameter.
+type ReadOnly2[A, B IComponent] struct{}
+
+// Components in the query results are read-only.
+// The components in the query results will be temporary copies.
+//
+// This allows parallelization of systems that use this query as a system parameter.
+type ReadOnly3[A, B, C IComponent] struct{}
+
+// Components in the query results are read-only.
+// The components in the query results will be temporary copies.
+//
+// This allows parallelization of systems that use this query as a system parameter.
+type ReadOnly4[A, B, C, D IComponent] struct{}
+
+// Components in the query results are read-only.
+// The components in the query results will be temporary copies.
+//
+// This allows parallelization of systems that use this query as a system parameter.
+type ReadOnly5[A, B, C, D, E IComponent] struct{}
+
+// Components in the query results are read-only.
+// The components in the query results will be temporary copies.
+//
+// This allows parallelization of systems that use this query as a system parameter.
+type ReadOnly6[A, B, C, D, E, F IComponent] struct{}
+
+// Components in the query results are read-only.
+// The components in the query results will be temporary copies.
+//
+// This allows parallelization of systems that use this query as a system parameter.
+type ReadOnly7[A, B, C, D, E, F, G IComponent] struct{}
+
+// Components in the query results are read-only.
+// The components in the query results will be temporary copies.
+//
+// This allows parallelization of systems that use this query as a system parameter.
+type ReadOnly8[A, B, C, D, E, F, G, H IComponent] struct{}
+
+// Components in the query results are read-only.
+// The components in the query results will be temporary copies.
+//
+// This allows parallelization of systems that use this query as a system parameter.
+type ReadOnly9[A, B, C, D, E, F, G, H, I IComponent] struct{}
+
+// Components in the query results are read-only.
+// The components in the query results will be temporary copies.
+//
+// This allows parallelization of systems that use this query as a system parameter.
+type ReadOnly10[A, B, C, D, E, F, G, H, I, J IComponent] struct{}
+
+// Components in the query results are read-only.
+// The components in the query results will be temporary copies.
+//
+// This allows parallelization of systems that use this query as a system parameter.
+type ReadOnly11[A, B, C, D, E, F, G, H, I, J, K IComponent] struct{}
+
+// Components in the query results are read-only.
+// The components in the query results will be temporary copies.
+//
+// This allows parallelization of systems that use this query as a system parameter.
+type ReadOnly12[A, B, C, D, E, F, G, H, I, J, K, L IComponent] struct{}
+
+// Components in the query results are read-only.
+// The components in the query results will be temporary copies.
+//
+// This allows parallelization of systems that use this query as a system parameter.
+type ReadOnly13[A, B, C, D, E, F, G, H, I, J, K, L, M IComponent] struct{}
+
+// Components in the query results are read-only.
+// The components in the query results will be temporary copies.
+//
+// This allows parallelization of systems that use this query as a system parameter.
+type ReadOnly14[A, B, C, D, E, F, G, H, I, J, K, L, M, N IComponent] struct{}
+
+// Components in the query results are read-only.
+// The components in the query results will be temporary copies.
+//
+// This allows parallelization of systems that use this query as a system parameter.
+type ReadOnly15[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O IComponent] struct{}
+
+// Components in the query results are read-only.
+// The components in the query results will be temporary copies.
+//
+// This allows parallelization of systems that use this query as a system parameter.
+type ReadOnly16[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P IComponent] struct{}
+
+func (o ReadOnly1[A]) getReadonlyComponentIds(world *World) (readOnlyComponentIds []ComponentId, isAllReadyOnly bool) {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+	}, false
+}
+
+func (o ReadOnly2[A, B]) getReadonlyComponentIds(world *World) (readOnlyComponentIds []ComponentId, isAllReadyOnly bool) {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+	}, false
+}
+
+func (o ReadOnly3[A, B, C]) getReadonlyComponentIds(world *World) (readOnlyComponentIds []ComponentId, isAllReadyOnly bool) {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+	}, false
+}
+
+func (o ReadOnly4[A, B, C, D]) getReadonlyComponentIds(world *World) (readOnlyComponentIds []ComponentId, isAllReadyOnly bool) {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+	}, false
+}
+
+func (o ReadOnly5[A, B, C, D, E]) getReadonlyComponentIds(world *World) (readOnlyComponentIds []ComponentId, isAllReadyOnly bool) {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+	}, false
+}
+
+func (o ReadOnly6[A, B, C, D, E, F]) getReadonlyComponentIds(world *World) (readOnlyComponentIds []ComponentId, isAllReadyOnly bool) {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+	}, false
+}
+
+func (o ReadOnly7[A, B, C, D, E, F, G]) getReadonlyComponentIds(world *World) (readOnlyComponentIds []ComponentId, isAllReadyOnly bool) {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+	}, false
+}
+
+func (o ReadOnly8[A, B, C, D, E, F, G, H]) getReadonlyComponentIds(world *World) (readOnlyComponentIds []ComponentId, isAllReadyOnly bool) {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+	}, false
+}
+
+func (o ReadOnly9[A, B, C, D, E, F, G, H, I]) getReadonlyComponentIds(world *World) (readOnlyComponentIds []ComponentId, isAllReadyOnly bool) {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+	}, false
+}
+
+func (o ReadOnly10[A, B, C, D, E, F, G, H, I, J]) getReadonlyComponentIds(world *World) (readOnlyComponentIds []ComponentId, isAllReadyOnly bool) {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+		ComponentIdFor[J](world),
+	}, false
+}
+
+func (o ReadOnly11[A, B, C, D, E, F, G, H, I, J, K]) getReadonlyComponentIds(world *World) (readOnlyComponentIds []ComponentId, isAllReadyOnly bool) {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+		ComponentIdFor[J](world),
+		ComponentIdFor[K](world),
+	}, false
+}
+
+func (o ReadOnly12[A, B, C, D, E, F, G, H, I, J, K, L]) getReadonlyComponentIds(world *World) (readOnlyComponentIds []ComponentId, isAllReadyOnly bool) {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+		ComponentIdFor[J](world),
+		ComponentIdFor[K](world),
+		ComponentIdFor[L](world),
+	}, false
+}
+
+func (o ReadOnly13[A, B, C, D, E, F, G, H, I, J, K, L, M]) getReadonlyComponentIds(world *World) (readOnlyComponentIds []ComponentId, isAllReadyOnly bool) {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+		ComponentIdFor[J](world),
+		ComponentIdFor[K](world),
+		ComponentIdFor[L](world),
+		ComponentIdFor[M](world),
+	}, false
+}
+
+func (o ReadOnly14[A, B, C, D, E, F, G, H, I, J, K, L, M, N]) getReadonlyComponentIds(world *World) (readOnlyComponentIds []ComponentId, isAllReadyOnly bool) {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+		ComponentIdFor[J](world),
+		ComponentIdFor[K](world),
+		ComponentIdFor[L](world),
+		ComponentIdFor[M](world),
+		ComponentIdFor[N](world),
+	}, false
+}
+
+func (o ReadOnly15[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O]) getReadonlyComponentIds(world *World) (readOnlyComponentIds []ComponentId, isAllReadyOnly bool) {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+		ComponentIdFor[J](world),
+		ComponentIdFor[K](world),
+		ComponentIdFor[L](world),
+		ComponentIdFor[M](world),
+		ComponentIdFor[N](world),
+		ComponentIdFor[O](world),
+	}, false
+}
+
+func (o ReadOnly16[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P]) getReadonlyComponentIds(world *World) (readOnlyComponentIds []ComponentId, isAllReadyOnly bool) {
+	return []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+		ComponentIdFor[J](world),
+		ComponentIdFor[K](world),
+		ComponentIdFor[L](world),
+		ComponentIdFor[M](world),
+		ComponentIdFor[N](world),
+		ComponentIdFor[O](world),
+		ComponentIdFor[P](world),
+	}, false
+}
+
+func (readOnly ReadOnly1[A]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, NoOptional, ReadOnly1[A], NotLazy, DefaultWorld]](world)
+}
+func (readOnly ReadOnly2[A, B]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, NoOptional, ReadOnly2[A, B], NotLazy, DefaultWorld]](world)
+}
+func (readOnly ReadOnly3[A, B, C]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, NoOptional, ReadOnly3[A, B, C], NotLazy, DefaultWorld]](world)
+}
+func (readOnly ReadOnly4[A, B, C, D]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, NoOptional, ReadOnly4[A, B, C, D], NotLazy, DefaultWorld]](world)
+}
+func (readOnly ReadOnly5[A, B, C, D, E]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, NoOptional, ReadOnly5[A, B, C, D, E], NotLazy, DefaultWorld]](world)
+}
+func (readOnly ReadOnly6[A, B, C, D, E, F]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, NoOptional, ReadOnly6[A, B, C, D, E, F], NotLazy, DefaultWorld]](world)
+}
+func (readOnly ReadOnly7[A, B, C, D, E, F, G]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, NoOptional, ReadOnly7[A, B, C, D, E, F, G], NotLazy, DefaultWorld]](world)
+}
+func (readOnly ReadOnly8[A, B, C, D, E, F, G, H]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, NoOptional, ReadOnly8[A, B, C, D, E, F, G, H], NotLazy, DefaultWorld]](world)
+}
+func (readOnly ReadOnly9[A, B, C, D, E, F, G, H, I]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, NoOptional, ReadOnly9[A, B, C, D, E, F, G, H, I], NotLazy, DefaultWorld]](world)
+}
+func (readOnly ReadOnly10[A, B, C, D, E, F, G, H, I, J]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, NoOptional, ReadOnly10[A, B, C, D, E, F, G, H, I, J], NotLazy, DefaultWorld]](world)
+}
+func (readOnly ReadOnly11[A, B, C, D, E, F, G, H, I, J, K]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, NoOptional, ReadOnly11[A, B, C, D, E, F, G, H, I, J, K], NotLazy, DefaultWorld]](world)
+}
+func (readOnly ReadOnly12[A, B, C, D, E, F, G, H, I, J, K, L]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, NoOptional, ReadOnly12[A, B, C, D, E, F, G, H, I, J, K, L], NotLazy, DefaultWorld]](world)
+}
+func (readOnly ReadOnly13[A, B, C, D, E, F, G, H, I, J, K, L, M]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, NoOptional, ReadOnly13[A, B, C, D, E, F, G, H, I, J, K, L, M], NotLazy, DefaultWorld]](world)
+}
+func (readOnly ReadOnly14[A, B, C, D, E, F, G, H, I, J, K, L, M, N]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, NoOptional, ReadOnly14[A, B, C, D, E, F, G, H, I, J, K, L, M, N], NotLazy, DefaultWorld]](world)
+}
+func (readOnly ReadOnly15[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, NoOptional, ReadOnly15[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O], NotLazy, DefaultWorld]](world)
+}
+func (readOnly ReadOnly16[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P]) GetCombinedQueryOptions(world *World) (CombinedQueryOptions, error) {
+	return toCombinedQueryOptions[QueryOptions[NoFilter, NoOptional, ReadOnly16[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P], NotLazy, DefaultWorld]](world)
+}
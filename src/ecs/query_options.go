@@ -26,6 +26,29 @@ func (o *CombinedQueryOptions) isEntityFilteredOut(entityData *EntityData) bool
 	return false
 }
 
+// isEntityFilteredOutByTicks returns wether the entity is filtered out, taking in to account when its components
+// were added or changed. lastRun is the change tick of the last time that the query was executed.
+func (o *CombinedQueryOptions) isEntityFilteredOutByTicks(entityData *EntityData, lastRun uint) bool {
+	for i := range o.Filters {
+		if !o.Filters[i].entityTicksMeetCriteria(entityData, lastRun) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// usesTicks returns wether any of the filters filters on change ticks.
+func (o *CombinedQueryOptions) usesTicks() bool {
+	for i := range o.Filters {
+		if o.Filters[i].usesTicks() {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (o *CombinedQueryOptions) isArchetypeFilteredOut(archetype *Archetype) bool {
 	for i := range o.Filters {
 		if !o.Filters[i].ArchetypeMeetsCriteria(archetype) {
@@ -164,6 +187,10 @@ func getFilterFromConcreteQueryParamFilter(filters QueryParamFilter, world *Worl
 		return &queryFilterWith{c: filters.getComponents(world)}, nil
 	case filterTypeWithout:
 		return &queryFilterWithout{c: filters.getComponents(world)}, nil
+	case filterTypeAdded:
+		return &queryFilterAdded{c: filters.getComponents(world)}, nil
+	case filterTypeChanged:
+		return &queryFilterChanged{c: filters.getComponents(world)}, nil
 	case filterTypeNone:
 		return nil, nil
 	case filterTypeAnd:
@@ -350,6 +377,22 @@ func QueryWithout[C IComponent](world *World, query Query) error {
 	return query.Validate()
 }
 
+func QueryWithAdded[C IComponent](world *World, query Query) error {
+	componentId := ComponentIdFor[C](world)
+	options := query.getOptions()
+	options.Filters = append(options.Filters, &queryFilterAdded{c: []ComponentId{componentId}})
+
+	return query.Validate()
+}
+
+func QueryWithChanged[C IComponent](world *World, query Query) error {
+	componentId := ComponentIdFor[C](world)
+	options := query.getOptions()
+	options.Filters = append(options.Filters, &queryFilterChanged{c: []ComponentId{componentId}})
+
+	return query.Validate()
+}
+
 func QueryWithFilters[Filters QueryParamFilter](world *World, query Query) error {
 	concreteFilters, err := utils.ToConcrete[Filters]()
 	if err != nil {
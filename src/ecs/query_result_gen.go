@@ -0,0 +1,685 @@
+// Code generated by internal/generate. DO NOT EDIT.
+
+package ecs
+
+type Query1Result[A IComponent] struct {
+	componentsA []*A
+	entityIds   []EntityId
+}
+
+type Query2Result[A, B IComponent] struct {
+	componentsA []*A
+	componentsB []*B
+	entityIds   []EntityId
+}
+
+type Query3Result[A, B, C IComponent] struct {
+	componentsA []*A
+	componentsB []*B
+	componentsC []*C
+	entityIds   []EntityId
+}
+
+type Query4Result[A, B, C, D IComponent] struct {
+	componentsA []*A
+	componentsB []*B
+	componentsC []*C
+	componentsD []*D
+	entityIds   []EntityId
+}
+
+type Query5Result[A, B, C, D, E IComponent] struct {
+	componentsA []*A
+	componentsB []*B
+	componentsC []*C
+	componentsD []*D
+	componentsE []*E
+	entityIds   []EntityId
+}
+
+type Query6Result[A, B, C, D, E, F IComponent] struct {
+	componentsA []*A
+	componentsB []*B
+	componentsC []*C
+	componentsD []*D
+	componentsE []*E
+	componentsF []*F
+	entityIds   []EntityId
+}
+
+type Query7Result[A, B, C, D, E, F, G IComponent] struct {
+	componentsA []*A
+	componentsB []*B
+	componentsC []*C
+	componentsD []*D
+	componentsE []*E
+	componentsF []*F
+	componentsG []*G
+	entityIds   []EntityId
+}
+
+type Query8Result[A, B, C, D, E, F, G, H IComponent] struct {
+	componentsA []*A
+	componentsB []*B
+	componentsC []*C
+	componentsD []*D
+	componentsE []*E
+	componentsF []*F
+	componentsG []*G
+	componentsH []*H
+	entityIds   []EntityId
+}
+
+type Query9Result[A, B, C, D, E, F, G, H, I IComponent] struct {
+	componentsA []*A
+	componentsB []*B
+	componentsC []*C
+	componentsD []*D
+	componentsE []*E
+	componentsF []*F
+	componentsG []*G
+	componentsH []*H
+	componentsI []*I
+	entityIds   []EntityId
+}
+
+type Query10Result[A, B, C, D, E, F, G, H, I, J IComponent] struct {
+	componentsA []*A
+	componentsB []*B
+	componentsC []*C
+	componentsD []*D
+	componentsE []*E
+	componentsF []*F
+	componentsG []*G
+	componentsH []*H
+	componentsI []*I
+	componentsJ []*J
+	entityIds   []EntityId
+}
+
+type Query11Result[A, B, C, D, E, F, G, H, I, J, K IComponent] struct {
+	componentsA []*A
+	componentsB []*B
+	componentsC []*C
+	componentsD []*D
+	componentsE []*E
+	componentsF []*F
+	componentsG []*G
+	componentsH []*H
+	componentsI []*I
+	componentsJ []*J
+	componentsK []*K
+	entityIds   []EntityId
+}
+
+type Query12Result[A, B, C, D, E, F, G, H, I, J, K, L IComponent] struct {
+	componentsA []*A
+	componentsB []*B
+	componentsC []*C
+	componentsD []*D
+	componentsE []*E
+	componentsF []*F
+	componentsG []*G
+	componentsH []*H
+	componentsI []*I
+	componentsJ []*J
+	componentsK []*K
+	componentsL []*L
+	entityIds   []EntityId
+}
+
+type Query13Result[A, B, C, D, E, F, G, H, I, J, K, L, M IComponent] struct {
+	componentsA []*A
+	componentsB []*B
+	componentsC []*C
+	componentsD []*D
+	componentsE []*E
+	componentsF []*F
+	componentsG []*G
+	componentsH []*H
+	componentsI []*I
+	componentsJ []*J
+	componentsK []*K
+	componentsL []*L
+	componentsM []*M
+	entityIds   []EntityId
+}
+
+type Query14Result[A, B, C, D, E, F, G, H, I, J, K, L, M, N IComponent] struct {
+	componentsA []*A
+	componentsB []*B
+	componentsC []*C
+	componentsD []*D
+	componentsE []*E
+	componentsF []*F
+	componentsG []*G
+	componentsH []*H
+	componentsI []*I
+	componentsJ []*J
+	componentsK []*K
+	componentsL []*L
+	componentsM []*M
+	componentsN []*N
+	entityIds   []EntityId
+}
+
+type Query15Result[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O IComponent] struct {
+	componentsA []*A
+	componentsB []*B
+	componentsC []*C
+	componentsD []*D
+	componentsE []*E
+	componentsF []*F
+	componentsG []*G
+	componentsH []*H
+	componentsI []*I
+	componentsJ []*J
+	componentsK []*K
+	componentsL []*L
+	componentsM []*M
+	componentsN []*N
+	componentsO []*O
+	entityIds   []EntityId
+}
+
+type Query16Result[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P IComponent] struct {
+	componentsA []*A
+	componentsB []*B
+	componentsC []*C
+	componentsD []*D
+	componentsE []*E
+	componentsF []*F
+	componentsG []*G
+	componentsH []*H
+	componentsI []*I
+	componentsJ []*J
+	componentsK []*K
+	componentsL []*L
+	componentsM []*M
+	componentsN []*N
+	componentsO []*O
+	componentsP []*P
+	entityIds   []EntityId
+}
+
+func (q *Query1Result[A]) Clear() {
+	q.componentsA = []*A{}
+	q.entityIds = []EntityId{}
+}
+
+func (q *Query2Result[A, B]) Clear() {
+	q.componentsA = []*A{}
+	q.componentsB = []*B{}
+	q.entityIds = []EntityId{}
+}
+
+func (q *Query3Result[A, B, C]) Clear() {
+	q.componentsA = []*A{}
+	q.componentsB = []*B{}
+	q.componentsC = []*C{}
+	q.entityIds = []EntityId{}
+}
+
+func (q *Query4Result[A, B, C, D]) Clear() {
+	q.componentsA = []*A{}
+	q.componentsB = []*B{}
+	q.componentsC = []*C{}
+	q.componentsD = []*D{}
+	q.entityIds = []EntityId{}
+}
+
+func (q *Query5Result[A, B, C, D, E]) Clear() {
+	q.componentsA = []*A{}
+	q.componentsB = []*B{}
+	q.componentsC = []*C{}
+	q.componentsD = []*D{}
+	q.componentsE = []*E{}
+	q.entityIds = []EntityId{}
+}
+
+func (q *Query6Result[A, B, C, D, E, F]) Clear() {
+	q.componentsA = []*A{}
+	q.componentsB = []*B{}
+	q.componentsC = []*C{}
+	q.componentsD = []*D{}
+	q.componentsE = []*E{}
+	q.componentsF = []*F{}
+	q.entityIds = []EntityId{}
+}
+
+func (q *Query7Result[A, B, C, D, E, F, G]) Clear() {
+	q.componentsA = []*A{}
+	q.componentsB = []*B{}
+	q.componentsC = []*C{}
+	q.componentsD = []*D{}
+	q.componentsE = []*E{}
+	q.componentsF = []*F{}
+	q.componentsG = []*G{}
+	q.entityIds = []EntityId{}
+}
+
+func (q *Query8Result[A, B, C, D, E, F, G, H]) Clear() {
+	q.componentsA = []*A{}
+	q.componentsB = []*B{}
+	q.componentsC = []*C{}
+	q.componentsD = []*D{}
+	q.componentsE = []*E{}
+	q.componentsF = []*F{}
+	q.componentsG = []*G{}
+	q.componentsH = []*H{}
+	q.entityIds = []EntityId{}
+}
+
+func (q *Query9Result[A, B, C, D, E, F, G, H, I]) Clear() {
+	q.componentsA = []*A{}
+	q.componentsB = []*B{}
+	q.componentsC = []*C{}
+	q.componentsD = []*D{}
+	q.componentsE = []*E{}
+	q.componentsF = []*F{}
+	q.componentsG = []*G{}
+	q.componentsH = []*H{}
+	q.componentsI = []*I{}
+	q.entityIds = []EntityId{}
+}
+
+func (q *Query10Result[A, B, C, D, E, F, G, H, I, J]) Clear() {
+	q.componentsA = []*A{}
+	q.componentsB = []*B{}
+	q.componentsC = []*C{}
+	q.componentsD = []*D{}
+	q.componentsE = []*E{}
+	q.componentsF = []*F{}
+	q.componentsG = []*G{}
+	q.componentsH = []*H{}
+	q.componentsI = []*I{}
+	q.componentsJ = []*J{}
+	q.entityIds = []EntityId{}
+}
+
+func (q *Query11Result[A, B, C, D, E, F, G, H, I, J, K]) Clear() {
+	q.componentsA = []*A{}
+	q.componentsB = []*B{}
+	q.componentsC = []*C{}
+	q.componentsD = []*D{}
+	q.componentsE = []*E{}
+	q.componentsF = []*F{}
+	q.componentsG = []*G{}
+	q.componentsH = []*H{}
+	q.componentsI = []*I{}
+	q.componentsJ = []*J{}
+	q.componentsK = []*K{}
+	q.entityIds = []EntityId{}
+}
+
+func (q *Query12Result[A, B, C, D, E, F, G, H, I, J, K, L]) Clear() {
+	q.componentsA = []*A{}
+	q.componentsB = []*B{}
+	q.componentsC = []*C{}
+	q.componentsD = []*D{}
+	q.componentsE = []*E{}
+	q.componentsF = []*F{}
+	q.componentsG = []*G{}
+	q.componentsH = []*H{}
+	q.componentsI = []*I{}
+	q.componentsJ = []*J{}
+	q.componentsK = []*K{}
+	q.componentsL = []*L{}
+	q.entityIds = []EntityId{}
+}
+
+func (q *Query13Result[A, B, C, D, E, F, G, H, I, J, K, L, M]) Clear() {
+	q.componentsA = []*A{}
+	q.componentsB = []*B{}
+	q.componentsC = []*C{}
+	q.componentsD = []*D{}
+	q.componentsE = []*E{}
+	q.componentsF = []*F{}
+	q.componentsG = []*G{}
+	q.componentsH = []*H{}
+	q.componentsI = []*I{}
+	q.componentsJ = []*J{}
+	q.componentsK = []*K{}
+	q.componentsL = []*L{}
+	q.componentsM = []*M{}
+	q.entityIds = []EntityId{}
+}
+
+func (q *Query14Result[A, B, C, D, E, F, G, H, I, J, K, L, M, N]) Clear() {
+	q.componentsA = []*A{}
+	q.componentsB = []*B{}
+	q.componentsC = []*C{}
+	q.componentsD = []*D{}
+	q.componentsE = []*E{}
+	q.componentsF = []*F{}
+	q.componentsG = []*G{}
+	q.componentsH = []*H{}
+	q.componentsI = []*I{}
+	q.componentsJ = []*J{}
+	q.componentsK = []*K{}
+	q.componentsL = []*L{}
+	q.componentsM = []*M{}
+	q.componentsN = []*N{}
+	q.entityIds = []EntityId{}
+}
+
+func (q *Query15Result[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O]) Clear() {
+	q.componentsA = []*A{}
+	q.componentsB = []*B{}
+	q.componentsC = []*C{}
+	q.componentsD = []*D{}
+	q.componentsE = []*E{}
+	q.componentsF = []*F{}
+	q.componentsG = []*G{}
+	q.componentsH = []*H{}
+	q.componentsI = []*I{}
+	q.componentsJ = []*J{}
+	q.componentsK = []*K{}
+	q.componentsL = []*L{}
+	q.componentsM = []*M{}
+	q.componentsN = []*N{}
+	q.componentsO = []*O{}
+	q.entityIds = []EntityId{}
+}
+
+func (q *Query16Result[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P]) Clear() {
+	q.componentsA = []*A{}
+	q.componentsB = []*B{}
+	q.componentsC = []*C{}
+	q.componentsD = []*D{}
+	q.componentsE = []*E{}
+	q.componentsF = []*F{}
+	q.componentsG = []*G{}
+	q.componentsH = []*H{}
+	q.componentsI = []*I{}
+	q.componentsJ = []*J{}
+	q.componentsK = []*K{}
+	q.componentsL = []*L{}
+	q.componentsM = []*M{}
+	q.componentsN = []*N{}
+	q.componentsO = []*O{}
+	q.componentsP = []*P{}
+	q.entityIds = []EntityId{}
+}
+
+func (q *Query1Result[A]) NumberOfResult() uint {
+	return uint(len(q.entityIds))
+}
+
+func (q *Query2Result[A, B]) NumberOfResult() uint {
+	return uint(len(q.entityIds))
+}
+
+func (q *Query3Result[A, B, C]) NumberOfResult() uint {
+	return uint(len(q.entityIds))
+}
+
+func (q *Query4Result[A, B, C, D]) NumberOfResult() uint {
+	return uint(len(q.entityIds))
+}
+
+func (q *Query5Result[A, B, C, D, E]) NumberOfResult() uint {
+	return uint(len(q.entityIds))
+}
+
+func (q *Query6Result[A, B, C, D, E, F]) NumberOfResult() uint {
+	return uint(len(q.entityIds))
+}
+
+func (q *Query7Result[A, B, C, D, E, F, G]) NumberOfResult() uint {
+	return uint(len(q.entityIds))
+}
+
+func (q *Query8Result[A, B, C, D, E, F, G, H]) NumberOfResult() uint {
+	return uint(len(q.entityIds))
+}
+
+func (q *Query9Result[A, B, C, D, E, F, G, H, I]) NumberOfResult() uint {
+	return uint(len(q.entityIds))
+}
+
+func (q *Query10Result[A, B, C, D, E, F, G, H, I, J]) NumberOfResult() uint {
+	return uint(len(q.entityIds))
+}
+
+func (q *Query11Result[A, B, C, D, E, F, G, H, I, J, K]) NumberOfResult() uint {
+	return uint(len(q.entityIds))
+}
+
+func (q *Query12Result[A, B, C, D, E, F, G, H, I, J, K, L]) NumberOfResult() uint {
+	return uint(len(q.entityIds))
+}
+
+func (q *Query13Result[A, B, C, D, E, F, G, H, I, J, K, L, M]) NumberOfResult() uint {
+	return uint(len(q.entityIds))
+}
+
+func (q *Query14Result[A, B, C, D, E, F, G, H, I, J, K, L, M, N]) NumberOfResult() uint {
+	return uint(len(q.entityIds))
+}
+
+func (q *Query15Result[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O]) NumberOfResult() uint {
+	return uint(len(q.entityIds))
+}
+
+func (q *Query16Result[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P]) NumberOfResult() uint {
+	return uint(len(q.entityIds))
+}
+
+// Range lets you range over the query result
+//
+// for component := range queryResult.Range() { ... }
+func (q *Query1Result[A]) Range() func(yield func(*A) bool) {
+	return func(yield func(*A) bool) {
+		for i := range q.entityIds {
+			if !yield(q.componentsA[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Range lets you range over the query result
+//
+// for component := range queryResult.Range() { ... }
+func (q *Query2Result[A, B]) Range() func(yield func(*A, *B) bool) {
+	return func(yield func(*A, *B) bool) {
+		for i := range q.entityIds {
+			if !yield(q.componentsA[i], q.componentsB[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Iter executes function f on each entity that the query returned, until f returns an error.
+// If any of the calls to f returned an error, this function returns that error.
+func (q *Query1Result[A]) Iter(f func(entityId EntityId, a *A) error) error {
+	for i := range q.entityIds {
+		if err := f(q.entityIds[i], q.componentsA[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Iter executes function f on each entity that the query returned, until f returns an error.
+// If any of the calls to f returned an error, this function returns that error.
+func (q *Query2Result[A, B]) Iter(f func(entityId EntityId, a *A, b *B) error) error {
+	for i := range q.entityIds {
+		if err := f(q.entityIds[i], q.componentsA[i], q.componentsB[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Iter executes function f on each entity that the query returned, until f returns an error.
+// If any of the calls to f returned an error, this function returns that error.
+func (q *Query3Result[A, B, C]) Iter(f func(entityId EntityId, a *A, b *B, c *C) error) error {
+	for i := range q.entityIds {
+		if err := f(q.entityIds[i], q.componentsA[i], q.componentsB[i], q.componentsC[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Iter executes function f on each entity that the query returned, until f returns an error.
+// If any of the calls to f returned an error, this function returns that error.
+func (q *Query4Result[A, B, C, D]) Iter(f func(entityId EntityId, a *A, b *B, c *C, d *D) error) error {
+	for i := range q.entityIds {
+		if err := f(q.entityIds[i], q.componentsA[i], q.componentsB[i], q.componentsC[i], q.componentsD[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Iter executes function f on each entity that the query returned, until f returns an error.
+// If any of the calls to f returned an error, this function returns that error.
+func (q *Query5Result[A, B, C, D, E]) Iter(f func(entityId EntityId, a *A, b *B, c *C, d *D, e *E) error) error {
+	for i := range q.entityIds {
+		if err := f(q.entityIds[i], q.componentsA[i], q.componentsB[i], q.componentsC[i], q.componentsD[i], q.componentsE[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Iter executes function f on each entity that the query returned, until f returns an error.
+// If any of the calls to f returned an error, this function returns that error.
+func (q *Query6Result[A, B, C, D, E, F]) Iter(f func(entityId EntityId, a *A, b *B, c *C, d *D, e *E, f *F) error) error {
+	for i := range q.entityIds {
+		if err := f(q.entityIds[i], q.componentsA[i], q.componentsB[i], q.componentsC[i], q.componentsD[i], q.componentsE[i], q.componentsF[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Iter executes function f on each entity that the query returned, until f returns an error.
+// If any of the calls to f returned an error, this function returns that error.
+func (q *Query7Result[A, B, C, D, E, F, G]) Iter(f func(entityId EntityId, a *A, b *B, c *C, d *D, e *E, f *F, g *G) error) error {
+	for i := range q.entityIds {
+		if err := f(q.entityIds[i], q.componentsA[i], q.componentsB[i], q.componentsC[i], q.componentsD[i], q.componentsE[i], q.componentsF[i], q.componentsG[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Iter executes function f on each entity that the query returned, until f returns an error.
+// If any of the calls to f returned an error, this function returns that error.
+func (q *Query8Result[A, B, C, D, E, F, G, H]) Iter(f func(entityId EntityId, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H) error) error {
+	for i := range q.entityIds {
+		if err := f(q.entityIds[i], q.componentsA[i], q.componentsB[i], q.componentsC[i], q.componentsD[i], q.componentsE[i], q.componentsF[i], q.componentsG[i], q.componentsH[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Iter executes function f on each entity that the query returned, until f returns an error.
+// If any of the calls to f returned an error, this function returns that error.
+func (q *Query9Result[A, B, C, D, E, F, G, H, I]) Iter(f func(entityId EntityId, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I) error) error {
+	for i := range q.entityIds {
+		if err := f(q.entityIds[i], q.componentsA[i], q.componentsB[i], q.componentsC[i], q.componentsD[i], q.componentsE[i], q.componentsF[i], q.componentsG[i], q.componentsH[i], q.componentsI[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Iter executes function f on each entity that the query returned, until f returns an error.
+// If any of the calls to f returned an error, this function returns that error.
+func (q *Query10Result[A, B, C, D, E, F, G, H, I, J]) Iter(f func(entityId EntityId, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J) error) error {
+	for i := range q.entityIds {
+		if err := f(q.entityIds[i], q.componentsA[i], q.componentsB[i], q.componentsC[i], q.componentsD[i], q.componentsE[i], q.componentsF[i], q.componentsG[i], q.componentsH[i], q.componentsI[i], q.componentsJ[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Iter executes function f on each entity that the query returned, until f returns an error.
+// If any of the calls to f returned an error, this function returns that error.
+func (q *Query11Result[A, B, C, D, E, F, G, H, I, J, K]) Iter(f func(entityId EntityId, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K) error) error {
+	for i := range q.entityIds {
+		if err := f(q.entityIds[i], q.componentsA[i], q.componentsB[i], q.componentsC[i], q.componentsD[i], q.componentsE[i], q.componentsF[i], q.componentsG[i], q.componentsH[i], q.componentsI[i], q.componentsJ[i], q.componentsK[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Iter executes function f on each entity that the query returned, until f returns an error.
+// If any of the calls to f returned an error, this function returns that error.
+func (q *Query12Result[A, B, C, D, E, F, G, H, I, J, K, L]) Iter(f func(entityId EntityId, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K, l *L) error) error {
+	for i := range q.entityIds {
+		if err := f(q.entityIds[i], q.componentsA[i], q.componentsB[i], q.componentsC[i], q.componentsD[i], q.componentsE[i], q.componentsF[i], q.componentsG[i], q.componentsH[i], q.componentsI[i], q.componentsJ[i], q.componentsK[i], q.componentsL[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Iter executes function f on each entity that the query returned, until f returns an error.
+// If any of the calls to f returned an error, this function returns that error.
+func (q *Query13Result[A, B, C, D, E, F, G, H, I, J, K, L, M]) Iter(f func(entityId EntityId, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K, l *L, m *M) error) error {
+	for i := range q.entityIds {
+		if err := f(q.entityIds[i], q.componentsA[i], q.componentsB[i], q.componentsC[i], q.componentsD[i], q.componentsE[i], q.componentsF[i], q.componentsG[i], q.componentsH[i], q.componentsI[i], q.componentsJ[i], q.componentsK[i], q.componentsL[i], q.componentsM[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Iter executes function f on each entity that the query returned, until f returns an error.
+// If any of the calls to f returned an error, this function returns that error.
+func (q *Query14Result[A, B, C, D, E, F, G, H, I, J, K, L, M, N]) Iter(f func(entityId EntityId, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K, l *L, m *M, n *N) error) error {
+	for i := range q.entityIds {
+		if err := f(q.entityIds[i], q.componentsA[i], q.componentsB[i], q.componentsC[i], q.componentsD[i], q.componentsE[i], q.componentsF[i], q.componentsG[i], q.componentsH[i], q.componentsI[i], q.componentsJ[i], q.componentsK[i], q.componentsL[i], q.componentsM[i], q.componentsN[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Iter executes function f on each entity that the query returned, until f returns an error.
+// If any of the calls to f returned an error, this function returns that error.
+func (q *Query15Result[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O]) Iter(f func(entityId EntityId, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K, l *L, m *M, n *N, o *O) error) error {
+	for i := range q.entityIds {
+		if err := f(q.entityIds[i], q.componentsA[i], q.componentsB[i], q.componentsC[i], q.componentsD[i], q.componentsE[i], q.componentsF[i], q.componentsG[i], q.componentsH[i], q.componentsI[i], q.componentsJ[i], q.componentsK[i], q.componentsL[i], q.componentsM[i], q.componentsN[i], q.componentsO[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Iter executes function f on each entity that the query returned, until f returns an error.
+// If any of the calls to f returned an error, this function returns that error.
+func (q *Query16Result[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P]) Iter(f func(entityId EntityId, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K, l *L, m *M, n *N, o *O, p *P) error) error {
+	for i := range q.entityIds {
+		if err := f(q.entityIds[i], q.componentsA[i], q.componentsB[i], q.componentsC[i], q.componentsD[i], q.componentsE[i], q.componentsF[i], q.componentsG[i], q.componentsH[i], q.componentsI[i], q.componentsJ[i], q.componentsK[i], q.componentsL[i], q.componentsM[i], q.componentsN[i], q.componentsO[i], q.componentsP[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
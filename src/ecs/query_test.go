@@ -361,3 +361,228 @@ func TestQuery4(t *testing.T) {
 		var _ Query = &Query4[componentA, componentB, componentC, componentD, Default]{}
 	})
 }
+
+func TestQuery8(t *testing.T) {
+	type componentA struct {
+		Component
+		value int
+	}
+	type componentB struct {
+		Component
+		value int
+	}
+	type componentC struct {
+		Component
+		value int
+	}
+	type componentD struct {
+		Component
+		value int
+	}
+	type componentE struct {
+		Component
+		value int
+	}
+	type componentF struct {
+		Component
+		value int
+	}
+	type componentG struct {
+		Component
+		value int
+	}
+	type componentH struct {
+		Component
+		value int
+	}
+
+	t.Run("Query8 satisfies Query", func(t *testing.T) {
+		var _ Query = &Query8[componentA, componentB, componentC, componentD, componentE, componentF, componentG, componentH, Default]{}
+	})
+
+	t.Run("returns all 8 components", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		expectedEntity, err := Spawn(&world,
+			&componentA{value: 1},
+			&componentB{value: 2},
+			&componentC{value: 3},
+			&componentD{value: 4},
+			&componentE{value: 5},
+			&componentF{value: 6},
+			&componentG{value: 7},
+			&componentH{value: 8},
+		)
+		assert.NoError(err)
+		_, err = Spawn(&world, &componentA{})
+		assert.NoError(err)
+
+		query := Query8[componentA, componentB, componentC, componentD, componentE, componentF, componentG, componentH, Default]{}
+		err = query.Prepare(&world)
+		assert.NoError(err)
+		err = query.Exec(&world)
+		assert.NoError(err)
+		assert.Equal(uint(1), query.Result().NumberOfResult())
+
+		err = query.Result().Iter(func(entity EntityId, a *componentA, b *componentB, c *componentC, d *componentD, e *componentE, f *componentF, g *componentG, h *componentH) error {
+			assert.Equal(expectedEntity, entity)
+			assert.Equal(36, a.value+b.value+c.value+d.value+e.value+f.value+g.value+h.value)
+			return nil
+		})
+		assert.NoError(err)
+	})
+}
+
+func TestQuery16(t *testing.T) {
+	type componentA struct{ Component }
+	type componentB struct{ Component }
+	type componentC struct{ Component }
+	type componentD struct{ Component }
+	type componentE struct{ Component }
+	type componentF struct{ Component }
+	type componentG struct{ Component }
+	type componentH struct{ Component }
+	type componentI struct{ Component }
+	type componentJ struct{ Component }
+	type componentK struct{ Component }
+	type componentL struct{ Component }
+	type componentM struct{ Component }
+	type componentN struct{ Component }
+	type componentO struct{ Component }
+	type componentP struct{ Component }
+
+	t.Run("Query16 satisfies Query", func(t *testing.T) {
+		var _ Query = &Query16[componentA, componentB, componentC, componentD, componentE, componentF, componentG, componentH, componentI, componentJ, componentK, componentL, componentM, componentN, componentO, componentP, Default]{}
+	})
+}
+
+func TestQueryArchetypeCache(t *testing.T) {
+	type componentA struct{ Component }
+	type componentB struct{ Component }
+	type componentC struct{ Component }
+
+	t.Run("only keeps archetypes that match the query", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		_, err := Spawn(&world, &componentA{})
+		assert.NoError(err)
+		_, err = Spawn(&world, &componentA{}, &componentB{})
+		assert.NoError(err)
+		_, err = Spawn(&world, &componentB{})
+		assert.NoError(err)
+		_, err = Spawn(&world, &componentA{}, &componentC{})
+		assert.NoError(err)
+
+		query := Query1[componentA, Without[componentC]]{}
+		err = query.Prepare(&world)
+		assert.NoError(err)
+		err = query.Exec(&world)
+		assert.NoError(err)
+
+		assert.Equal(uint(2), query.Result().NumberOfResult())
+		assert.Len(query.archetypeCache.archetypes, 2)
+		assert.Equal(4, query.archetypeCache.numberOfCheckedArchetypes)
+	})
+
+	t.Run("picks up archetypes that are created after the previous Exec", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		query := Query1[componentA, Default]{}
+		err := query.Prepare(&world)
+		assert.NoError(err)
+
+		_, err = Spawn(&world, &componentA{})
+		assert.NoError(err)
+		err = query.Exec(&world)
+		assert.NoError(err)
+		assert.Equal(uint(1), query.Result().NumberOfResult())
+
+		entity, err := Spawn(&world, &componentB{})
+		assert.NoError(err)
+		err = Insert(&world, entity, &componentA{})
+		assert.NoError(err)
+		err = query.Exec(&world)
+		assert.NoError(err)
+		assert.Equal(uint(2), query.Result().NumberOfResult())
+		assert.Len(query.archetypeCache.archetypes, 2)
+		assert.Equal(world.CountArchetypes(), query.archetypeCache.numberOfCheckedArchetypes)
+	})
+
+	t.Run("altering the query options resets the cache", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		_, err := Spawn(&world, &componentA{})
+		assert.NoError(err)
+		_, err = Spawn(&world, &componentA{}, &componentB{})
+		assert.NoError(err)
+
+		query := Query1[componentA, Default]{}
+		err = query.Prepare(&world)
+		assert.NoError(err)
+		err = query.Exec(&world)
+		assert.NoError(err)
+		assert.Equal(uint(2), query.Result().NumberOfResult())
+
+		err = QueryWithout[componentB](&world, &query)
+		assert.NoError(err)
+		err = query.Exec(&world)
+		assert.NoError(err)
+		assert.Equal(uint(1), query.Result().NumberOfResult())
+	})
+
+	t.Run("resets the cache when executing in another world", func(t *testing.T) {
+		assert := assert.New(t)
+
+		worldA := NewDefaultWorld()
+		worldB := NewDefaultWorld()
+		_, err := Spawn(&worldA, &componentA{})
+		assert.NoError(err)
+
+		query := Query1[componentA, Default]{}
+		err = query.Prepare(&worldA)
+		assert.NoError(err)
+		err = query.Exec(&worldA)
+		assert.NoError(err)
+		assert.Equal(uint(1), query.Result().NumberOfResult())
+
+		err = query.Exec(&worldB)
+		assert.NoError(err)
+		assert.Equal(uint(0), query.Result().NumberOfResult())
+	})
+}
+
+func TestQueryComponentAccess(t *testing.T) {
+	type componentA struct{ Component }
+	type componentB struct{ Component }
+	type componentC struct{ Component }
+
+	t.Run("splits components in to read-only and mutable components", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		query := Query3[componentA, componentB, componentC, ReadOnly1[componentB]]{}
+		err := query.Prepare(&world)
+		assert.NoError(err)
+
+		readOnly, mutable := query.ComponentAccess()
+		assert.Equal([]ComponentId{ComponentIdFor[componentB](&world)}, readOnly)
+		assert.Equal([]ComponentId{ComponentIdFor[componentA](&world), ComponentIdFor[componentC](&world)}, mutable)
+	})
+
+	t.Run("all components are read-only with AllReadOnly", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		query := Query2[componentA, componentB, AllReadOnly]{}
+		err := query.Prepare(&world)
+		assert.NoError(err)
+
+		readOnly, mutable := query.ComponentAccess()
+		assert.Len(readOnly, 2)
+		assert.Empty(mutable)
+	})
+}
@@ -0,0 +1,228 @@
+// functions to manage parent/child relationships between entities
+package ecs
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Parent is the parent of an entity. It is maintained by the world, use SetParent and RemoveParent to
+// change it instead of inserting or removing this component directly.
+type Parent struct {
+	Component
+	entity EntityId
+}
+
+// Get returns the parent entity.
+func (p *Parent) Get() EntityId {
+	return p.entity
+}
+
+// Children are the children of an entity. It is maintained by the world, use SetParent and RemoveParent to
+// change it instead of inserting or removing this component directly.
+type Children struct {
+	Component
+	entities []EntityId
+}
+
+// Get returns the child entities.
+//
+// WARNING: Do not alter the returned slice
+func (c *Children) Get() []EntityId {
+	return c.entities
+}
+
+func (c *Children) Len() int {
+	return len(c.entities)
+}
+
+// SetParent makes parent the parent of child. If child already has a parent, it is removed from the children
+// of its old parent.
+//
+// Can return the following errors:
+//   - ErrEntityNotFound error if child or parent does not exist.
+//   - ErrRelationshipCycle error if child is parent, or if child is an ancestor of parent.
+func SetParent(world *World, child EntityId, parent EntityId) error {
+	if !world.IsAlive(child) || !world.IsAlive(parent) {
+		return ErrEntityNotFound
+	}
+
+	if child == parent {
+		return fmt.Errorf("%w: entity can not be its own parent", ErrRelationshipCycle)
+	}
+
+	for ancestor := range IterAncestors(world, parent) {
+		if ancestor == child {
+			return fmt.Errorf("%w: child %v is an ancestor of parent %v", ErrRelationshipCycle, child, parent)
+		}
+	}
+
+	if err := RemoveParent(world, child); err != nil && !errors.Is(err, ErrComponentNotFound) {
+		return err
+	}
+
+	if err := Insert(world, child, &Parent{entity: parent}); err != nil {
+		return fmt.Errorf("failed to insert parent: %w", err)
+	}
+
+	children, err := Get1[Children](world, parent)
+	if err == nil {
+		children.entities = append(children.entities, child)
+		return nil
+	}
+
+	if err := Insert(world, parent, &Children{entities: []EntityId{child}}); err != nil {
+		return fmt.Errorf("failed to insert children: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveParent removes the parent of child, and removes child from the children of its parent.
+//
+// Can return the following errors:
+//   - ErrEntityNotFound error if child does not exist.
+//   - ErrComponentNotFound error if child does not have a parent.
+func RemoveParent(world *World, child EntityId) error {
+	parent, err := Get1[Parent](world, child)
+	if err != nil {
+		return err
+	}
+	parentEntity := parent.entity
+
+	if err := Remove1[Parent](world, child); err != nil {
+		return fmt.Errorf("failed to remove parent: %w", err)
+	}
+
+	return removeChild(world, parentEntity, child)
+}
+
+// removeChild removes child from the Children of parent. Children is removed from parent if it has no more
+// children left. Does nothing if parent does not exist anymore.
+func removeChild(world *World, parent EntityId, child EntityId) error {
+	children, err := Get1[Children](world, parent)
+	if err != nil {
+		return nil
+	}
+
+	children.entities = slices.DeleteFunc(children.entities, func(entity EntityId) bool {
+		return entity == child
+	})
+
+	if len(children.entities) == 0 {
+		if err := Remove1[Children](world, parent); err != nil {
+			return fmt.Errorf("failed to remove children: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// removeRelationships removes entity from the children of its parent, and removes the parent of all its
+// children so that no entity points to entity anymore.
+func removeRelationships(world *World, entity EntityId) error {
+	if parent, err := Get1[Parent](world, entity); err == nil {
+		if err := removeChild(world, parent.entity, entity); err != nil {
+			return err
+		}
+	}
+
+	children, err := Get1[Children](world, entity)
+	if err != nil {
+		return nil
+	}
+
+	// copy because removing the parent of a child moves the components of entity
+	for _, child := range slices.Clone(children.entities) {
+		if err := Remove1[Parent](world, child); err != nil {
+			return fmt.Errorf("failed to remove parent of child %v: %w", child, err)
+		}
+	}
+
+	return nil
+}
+
+// DeleteRecursive deletes entity and all of its descendants.
+//
+// Returns an ErrEntityNotFound error if the entity did not exist in the world.
+func DeleteRecursive(world *World, entity EntityId) error {
+	if !world.IsAlive(entity) {
+		return ErrEntityNotFound
+	}
+
+	descendants := []EntityId{}
+	for descendant := range IterDescendants(world, entity) {
+		descendants = append(descendants, descendant)
+	}
+
+	// delete in reverse breadth-first order so that children are always deleted before their parent
+	for _, descendant := range slices.Backward(descendants) {
+		if err := Delete(world, descendant); err != nil {
+			return fmt.Errorf("failed to delete descendant %v: %w", descendant, err)
+		}
+	}
+
+	return Delete(world, entity)
+}
+
+// IterChildren lets you range over the children of entity.
+//
+// for child := range ecs.IterChildren(&world, entity) { ... }
+func IterChildren(world *World, entity EntityId) func(yield func(EntityId) bool) {
+	return func(yield func(EntityId) bool) {
+		children, err := Get1[Children](world, entity)
+		if err != nil {
+			return
+		}
+
+		// copy because yield could alter the children
+		for _, child := range slices.Clone(children.entities) {
+			if !yield(child) {
+				return
+			}
+		}
+	}
+}
+
+// IterDescendants lets you range over the children of entity, their children and so on, in breadth-first order.
+//
+// for descendant := range ecs.IterDescendants(&world, entity) { ... }
+func IterDescendants(world *World, entity EntityId) func(yield func(EntityId) bool) {
+	return func(yield func(EntityId) bool) {
+		queue := []EntityId{entity}
+
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+
+			for child := range IterChildren(world, current) {
+				if !yield(child) {
+					return
+				}
+				queue = append(queue, child)
+			}
+		}
+	}
+}
+
+// IterAncestors lets you range over the parent of entity, its parent and so on.
+//
+// for ancestor := range ecs.IterAncestors(&world, entity) { ... }
+func IterAncestors(world *World, entity EntityId) func(yield func(EntityId) bool) {
+	return func(yield func(EntityId) bool) {
+		current := entity
+
+		for {
+			parent, err := Get1[Parent](world, current)
+			if err != nil {
+				return
+			}
+
+			current = parent.entity
+			if !yield(current) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package ecs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetParent(t *testing.T) {
+	t.Run("returns an error if child or parent does not exist", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		entity, err := Spawn(&world)
+		assert.NoError(err)
+
+		err = SetParent(&world, entity, nonExistingEntity)
+		assert.ErrorIs(err, ErrEntityNotFound)
+		err = SetParent(&world, nonExistingEntity, entity)
+		assert.ErrorIs(err, ErrEntityNotFound)
+	})
+
+	t.Run("returns an error when creating a cycle", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		grandParent, err := Spawn(&world)
+		assert.NoError(err)
+		parent, err := Spawn(&world)
+		assert.NoError(err)
+		child, err := Spawn(&world)
+		assert.NoError(err)
+
+		assert.NoError(SetParent(&world, parent, grandParent))
+		assert.NoError(SetParent(&world, child, parent))
+
+		err = SetParent(&world, child, child)
+		assert.ErrorIs(err, ErrRelationshipCycle)
+		err = SetParent(&world, grandParent, child)
+		assert.ErrorIs(err, ErrRelationshipCycle)
+	})
+
+	t.Run("sets Parent and Children", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		parent, err := Spawn(&world, &emptyComponentA{})
+		assert.NoError(err)
+		child1, err := Spawn(&world)
+		assert.NoError(err)
+		child2, err := Spawn(&world)
+		assert.NoError(err)
+
+		assert.NoError(SetParent(&world, child1, parent))
+		assert.NoError(SetParent(&world, child2, parent))
+
+		parentComponent, err := Get1[Parent](&world, child1)
+		assert.NoError(err)
+		assert.Equal(parent, parentComponent.Get())
+
+		children, err := Get1[Children](&world, parent)
+		assert.NoError(err)
+		assert.Equal([]EntityId{child1, child2}, children.Get())
+	})
+
+	t.Run("moves child from old parent to new parent", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		oldParent, err := Spawn(&world)
+		assert.NoError(err)
+		newParent, err := Spawn(&world)
+		assert.NoError(err)
+		child, err := Spawn(&world)
+		assert.NoError(err)
+
+		assert.NoError(SetParent(&world, child, oldParent))
+		assert.NoError(SetParent(&world, child, newParent))
+
+		hasChildren, err := HasComponent[Children](&world, oldParent)
+		assert.NoError(err)
+		assert.False(hasChildren)
+
+		parentComponent, err := Get1[Parent](&world, child)
+		assert.NoError(err)
+		assert.Equal(newParent, parentComponent.Get())
+	})
+}
+
+func TestRemoveParent(t *testing.T) {
+	assert := assert.New(t)
+
+	world := NewDefaultWorld()
+	parent, err := Spawn(&world)
+	assert.NoError(err)
+	child1, err := Spawn(&world)
+	assert.NoError(err)
+	child2, err := Spawn(&world)
+	assert.NoError(err)
+	assert.NoError(SetParent(&world, child1, parent))
+	assert.NoError(SetParent(&world, child2, parent))
+
+	assert.NoError(RemoveParent(&world, child1))
+	hasParent, err := HasComponent[Parent](&world, child1)
+	assert.NoError(err)
+	assert.False(hasParent)
+	children, err := Get1[Children](&world, parent)
+	assert.NoError(err)
+	assert.Equal([]EntityId{child2}, children.Get())
+
+	err = RemoveParent(&world, child1)
+	assert.ErrorIs(err, ErrComponentNotFound)
+}
+
+func TestIterRelationships(t *testing.T) {
+	assert := assert.New(t)
+
+	// root
+	//  ├── a
+	//  │   └── c
+	//  └── b
+	world := NewDefaultWorld()
+	root, err := Spawn(&world)
+	assert.NoError(err)
+	a, err := Spawn(&world)
+	assert.NoError(err)
+	b, err := Spawn(&world)
+	assert.NoError(err)
+	c, err := Spawn(&world)
+	assert.NoError(err)
+	assert.NoError(SetParent(&world, a, root))
+	assert.NoError(SetParent(&world, b, root))
+	assert.NoError(SetParent(&world, c, a))
+
+	collect := func(seq func(yield func(EntityId) bool)) []EntityId {
+		result := []EntityId{}
+		for entity := range seq {
+			result = append(result, entity)
+		}
+		return result
+	}
+
+	assert.Equal([]EntityId{a, b}, collect(IterChildren(&world, root)))
+	assert.Equal([]EntityId{}, collect(IterChildren(&world, c)))
+	assert.Equal([]EntityId{a, b, c}, collect(IterDescendants(&world, root)))
+	assert.Equal([]EntityId{a, root}, collect(IterAncestors(&world, c)))
+	assert.Equal([]EntityId{}, collect(IterAncestors(&world, root)))
+}
+
+func TestDeleteWithRelationships(t *testing.T) {
+	setup := func(assert *assert.Assertions) (world World, root, a, b, c EntityId) {
+		// root
+		//  ├── a
+		//  │   └── c
+		//  └── b
+		world = NewDefaultWorld()
+		var err error
+		root, err = Spawn(&world, &emptyComponentA{})
+		assert.NoError(err)
+		a, err = Spawn(&world, &emptyComponentA{})
+		assert.NoError(err)
+		b, err = Spawn(&world, &emptyComponentA{})
+		assert.NoError(err)
+		c, err = Spawn(&world, &emptyComponentA{})
+		assert.NoError(err)
+		assert.NoError(SetParent(&world, a, root))
+		assert.NoError(SetParent(&world, b, root))
+		assert.NoError(SetParent(&world, c, a))
+		return world, root, a, b, c
+	}
+
+	t.Run("Delete removes the entity from its parent and orphans its children", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world, root, a, b, c := setup(assert)
+		assert.NoError(Delete(&world, a))
+
+		children, err := Get1[Children](&world, root)
+		assert.NoError(err)
+		assert.Equal([]EntityId{b}, children.Get())
+
+		hasParent, err := HasComponent[Parent](&world, c)
+		assert.NoError(err)
+		assert.False(hasParent)
+		assert.Equal(3, world.CountEntities())
+	})
+
+	t.Run("DeleteRecursive deletes the whole subtree", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world, root, a, b, c := setup(assert)
+		assert.NoError(DeleteRecursive(&world, a))
+
+		assert.False(world.IsAlive(a))
+		assert.False(world.IsAlive(c))
+		assert.True(world.IsAlive(b))
+		children, err := Get1[Children](&world, root)
+		assert.NoError(err)
+		assert.Equal([]EntityId{b}, children.Get())
+
+		assert.NoError(DeleteRecursive(&world, root))
+		assert.Equal(0, world.CountEntities())
+
+		err = DeleteRecursive(&world, root)
+		assert.ErrorIs(err, ErrEntityNotFound)
+	})
+}
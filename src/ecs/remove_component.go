@@ -8,58 +8,8 @@ import (
 	"github.com/lucdrenth/murphecs/src/utils"
 )
 
-// Remove1 removes the given component from entity.
-//
-// Can return the following errors:
-//   - ErrEntityNotFound error if the entity does not exist in world.
-//   - ErrComponentNotFound error if the component is not present in the entity.
-func Remove1[A IComponent](world *World, entity EntityId) error {
-	return removeComponents(world, entity, []ComponentId{
-		ComponentIdFor[A](world),
-	})
-}
-
-// Remove2 removes the given components from entity.
-//
-// Can return the following errors:
-//   - ErrEntityNotFound error if the entity does not exist in world.
-//   - ErrComponentNotFound error if the component is not present in the entity.
-func Remove2[A, B IComponent](world *World, entity EntityId) (result error) {
-	return removeComponents(world, entity, []ComponentId{
-		ComponentIdFor[A](world),
-		ComponentIdFor[B](world),
-	})
-}
-
-// Remove3 removes the given components from entity.
-//
-// Can return the following errors:
-//   - ErrEntityNotFound error if the entity does not exist in world.
-//   - ErrComponentNotFound error if the component is not present in the entity.
-func Remove3[A, B, C IComponent](world *World, entity EntityId) (result error) {
-	return removeComponents(world, entity, []ComponentId{
-		ComponentIdFor[A](world),
-		ComponentIdFor[B](world),
-		ComponentIdFor[C](world),
-	})
-}
-
-// Remove4 removes the given components from entity.
-//
-// Can return the following errors:
-//   - ErrEntityNotFound error if the entity does not exist in world.
-//   - ErrComponentNotFound error if the component is not present in the entity.
-func Remove4[A, B, C, D IComponent](world *World, entity EntityId) (result error) {
-	return removeComponents(world, entity, []ComponentId{
-		ComponentIdFor[A](world),
-		ComponentIdFor[B](world),
-		ComponentIdFor[C](world),
-		ComponentIdFor[D](world),
-	})
-}
-
 func removeComponents(world *World, entityId EntityId, componentIds []ComponentId) (resultErr error) {
-	entity, ok := world.entities[entityId]
+	entity, ok := world.getEntityData(entityId)
 	if !ok {
 		return ErrEntityNotFound
 	}
@@ -82,16 +32,23 @@ func removeComponents(world *World, entityId EntityId, componentIds []ComponentI
 		return resultErr
 	}
 
-	oldArchetype := entity.archetype
+	world.triggerObservers(observerEventRemove, entityId, componentIdsToRemove)
 
-	newComponentIds := make([]ComponentId, 0, len(oldArchetype.componentIds))
-	for _, componentId := range oldArchetype.componentIds {
-		if !slices.Contains(componentIdsToRemove, componentId) {
-			newComponentIds = append(newComponentIds, componentId)
-		}
+	// observers could have made structural changes to the entity
+	entity, ok = world.getEntityData(entityId)
+	if !ok {
+		return ErrEntityNotFound
 	}
+	componentIdsToRemove = slices.DeleteFunc(componentIdsToRemove, func(componentId ComponentId) bool {
+		return !entity.archetype.HasComponent(componentId)
+	})
+	if len(componentIdsToRemove) == 0 {
+		return resultErr
+	}
+
+	oldArchetype := entity.archetype
 
-	newArchetype, err := world.archetypeStorage.getArchetype(world, newComponentIds)
+	newArchetype, err := world.archetypeStorage.getArchetypeWithRemovedComponents(world, oldArchetype, componentIdsToRemove)
 	if err != nil {
 		return err
 	}
@@ -108,7 +65,7 @@ func removeComponents(world *World, entityId EntityId, componentIds []ComponentI
 			}
 
 			storage := newArchetype.components[componentId]
-			newRow, err = storage.insertRaw(world, rawComponent)
+			newRow, err = storage.insertRaw(world, rawComponent, oldStorage.ticks[entity.row])
 			if err != nil {
 				resultErr = err
 				continue
@@ -132,7 +89,6 @@ func removeComponents(world *World, entityId EntityId, componentIds []ComponentI
 
 	entity.archetype = newArchetype
 	entity.row = newRow
-	world.archetypeStorage.entityIdToArchetype[entityId] = newArchetype
 	newArchetype.entities = append(newArchetype.entities, entityId)
 
 	return resultErr
@@ -144,7 +100,7 @@ func handleComponentStorageIndexMove(world *World, movedComponent *movedComponen
 	}
 
 	for _, entityId := range archetype.entities {
-		entityData := world.entities[entityId]
+		entityData := &world.entities[entityId.index]
 
 		if entityData.row == movedComponent.fromIndex {
 			entityData.row = movedComponent.toIndex
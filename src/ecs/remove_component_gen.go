@@ -0,0 +1,411 @@
+// Code generated by internal/generate. DO NOT EDIT.
+
+package ecs
+
+// Remove1 removes the given component from entity.
+//
+// Can return the following errors:
+//   - ErrEntityNotFound error if the entity does not exist in world.
+//   - ErrComponentNotFound error if the component is not present in the entity.
+func Remove1[A IComponent](world *World, entity EntityId) error {
+	return removeComponents(world, entity, []ComponentId{
+		ComponentIdFor[A](world),
+	})
+}
+
+// CommandsRemove1 records removing the given component from entity. See [Remove1].
+func CommandsRemove1[A IComponent](commands *Commands, entity EntityId) {
+	commands.add(func(world *World) error {
+		return Remove1[A](world, entity)
+	})
+}
+
+// Remove2 removes the given components from entity.
+//
+// Can return the following errors:
+//   - ErrEntityNotFound error if the entity does not exist in world.
+//   - ErrComponentNotFound error if the component is not present in the entity.
+func Remove2[A, B IComponent](world *World, entity EntityId) error {
+	return removeComponents(world, entity, []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+	})
+}
+
+// CommandsRemove2 records removing the given components from entity. See [Remove2].
+func CommandsRemove2[A, B IComponent](commands *Commands, entity EntityId) {
+	commands.add(func(world *World) error {
+		return Remove2[A, B](world, entity)
+	})
+}
+
+// Remove3 removes the given components from entity.
+//
+// Can return the following errors:
+//   - ErrEntityNotFound error if the entity does not exist in world.
+//   - ErrComponentNotFound error if the component is not present in the entity.
+func Remove3[A, B, C IComponent](world *World, entity EntityId) error {
+	return removeComponents(world, entity, []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+	})
+}
+
+// CommandsRemove3 records removing the given components from entity. See [Remove3].
+func CommandsRemove3[A, B, C IComponent](commands *Commands, entity EntityId) {
+	commands.add(func(world *World) error {
+		return Remove3[A, B, C](world, entity)
+	})
+}
+
+// Remove4 removes the given components from entity.
+//
+// Can return the following errors:
+//   - ErrEntityNotFound error if the entity does not exist in world.
+//   - ErrComponentNotFound error if the component is not present in the entity.
+func Remove4[A, B, C, D IComponent](world *World, entity EntityId) error {
+	return removeComponents(world, entity, []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+	})
+}
+
+// CommandsRemove4 records removing the given components from entity. See [Remove4].
+func CommandsRemove4[A, B, C, D IComponent](commands *Commands, entity EntityId) {
+	commands.add(func(world *World) error {
+		return Remove4[A, B, C, D](world, entity)
+	})
+}
+
+// Remove5 removes the given components from entity.
+//
+// Can return the following errors:
+//   - ErrEntityNotFound error if the entity does not exist in world.
+//   - ErrComponentNotFound error if the component is not present in the entity.
+func Remove5[A, B, C, D, E IComponent](world *World, entity EntityId) error {
+	return removeComponents(world, entity, []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+	})
+}
+
+// CommandsRemove5 records removing the given components from entity. See [Remove5].
+func CommandsRemove5[A, B, C, D, E IComponent](commands *Commands, entity EntityId) {
+	commands.add(func(world *World) error {
+		return Remove5[A, B, C, D, E](world, entity)
+	})
+}
+
+// Remove6 removes the given components from entity.
+//
+// Can return the following errors:
+//   - ErrEntityNotFound error if the entity does not exist in world.
+//   - ErrComponentNotFound error if the component is not present in the entity.
+func Remove6[A, B, C, D, E, F IComponent](world *World, entity EntityId) error {
+	return removeComponents(world, entity, []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+	})
+}
+
+// CommandsRemove6 records removing the given components from entity. See [Remove6].
+func CommandsRemove6[A, B, C, D, E, F IComponent](commands *Commands, entity EntityId) {
+	commands.add(func(world *World) error {
+		return Remove6[A, B, C, D, E, F](world, entity)
+	})
+}
+
+// Remove7 removes the given components from entity.
+//
+// Can return the following errors:
+//   - ErrEntityNotFound error if the entity does not exist in world.
+//   - ErrComponentNotFound error if the component is not present in the entity.
+func Remove7[A, B, C, D, E, F, G IComponent](world *World, entity EntityId) error {
+	return removeComponents(world, entity, []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+	})
+}
+
+// CommandsRemove7 records removing the given components from entity. See [Remove7].
+func CommandsRemove7[A, B, C, D, E, F, G IComponent](commands *Commands, entity EntityId) {
+	commands.add(func(world *World) error {
+		return Remove7[A, B, C, D, E, F, G](world, entity)
+	})
+}
+
+// Remove8 removes the given components from entity.
+//
+// Can return the following errors:
+//   - ErrEntityNotFound error if the entity does not exist in world.
+//   - ErrComponentNotFound error if the component is not present in the entity.
+func Remove8[A, B, C, D, E, F, G, H IComponent](world *World, entity EntityId) error {
+	return removeComponents(world, entity, []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+	})
+}
+
+// CommandsRemove8 records removing the given components from entity. See [Remove8].
+func CommandsRemove8[A, B, C, D, E, F, G, H IComponent](commands *Commands, entity EntityId) {
+	commands.add(func(world *World) error {
+		return Remove8[A, B, C, D, E, F, G, H](world, entity)
+	})
+}
+
+// Remove9 removes the given components from entity.
+//
+// Can return the following errors:
+//   - ErrEntityNotFound error if the entity does not exist in world.
+//   - ErrComponentNotFound error if the component is not present in the entity.
+func Remove9[A, B, C, D, E, F, G, H, I IComponent](world *World, entity EntityId) error {
+	return removeComponents(world, entity, []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+	})
+}
+
+// CommandsRemove9 records removing the given components from entity. See [Remove9].
+func CommandsRemove9[A, B, C, D, E, F, G, H, I IComponent](commands *Commands, entity EntityId) {
+	commands.add(func(world *World) error {
+		return Remove9[A, B, C, D, E, F, G, H, I](world, entity)
+	})
+}
+
+// Remove10 removes the given components from entity.
+//
+// Can return the following errors:
+//   - ErrEntityNotFound error if the entity does not exist in world.
+//   - ErrComponentNotFound error if the component is not present in the entity.
+func Remove10[A, B, C, D, E, F, G, H, I, J IComponent](world *World, entity EntityId) error {
+	return removeComponents(world, entity, []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+		ComponentIdFor[J](world),
+	})
+}
+
+// CommandsRemove10 records removing the given components from entity. See [Remove10].
+func CommandsRemove10[A, B, C, D, E, F, G, H, I, J IComponent](commands *Commands, entity EntityId) {
+	commands.add(func(world *World) error {
+		return Remove10[A, B, C, D, E, F, G, H, I, J](world, entity)
+	})
+}
+
+// Remove11 removes the given components from entity.
+//
+// Can return the following errors:
+//   - ErrEntityNotFound error if the entity does not exist in world.
+//   - ErrComponentNotFound error if the component is not present in the entity.
+func Remove11[A, B, C, D, E, F, G, H, I, J, K IComponent](world *World, entity EntityId) error {
+	return removeComponents(world, entity, []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+		ComponentIdFor[J](world),
+		ComponentIdFor[K](world),
+	})
+}
+
+// CommandsRemove11 records removing the given components from entity. See [Remove11].
+func CommandsRemove11[A, B, C, D, E, F, G, H, I, J, K IComponent](commands *Commands, entity EntityId) {
+	commands.add(func(world *World) error {
+		return Remove11[A, B, C, D, E, F, G, H, I, J, K](world, entity)
+	})
+}
+
+// Remove12 removes the given components from entity.
+//
+// Can return the following errors:
+//   - ErrEntityNotFound error if the entity does not exist in world.
+//   - ErrComponentNotFound error if the component is not present in the entity.
+func Remove12[A, B, C, D, E, F, G, H, I, J, K, L IComponent](world *World, entity EntityId) error {
+	return removeComponents(world, entity, []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+		ComponentIdFor[J](world),
+		ComponentIdFor[K](world),
+		ComponentIdFor[L](world),
+	})
+}
+
+// CommandsRemove12 records removing the given components from entity. See [Remove12].
+func CommandsRemove12[A, B, C, D, E, F, G, H, I, J, K, L IComponent](commands *Commands, entity EntityId) {
+	commands.add(func(world *World) error {
+		return Remove12[A, B, C, D, E, F, G, H, I, J, K, L](world, entity)
+	})
+}
+
+// Remove13 removes the given components from entity.
+//
+// Can return the following errors:
+//   - ErrEntityNotFound error if the entity does not exist in world.
+//   - ErrComponentNotFound error if the component is not present in the entity.
+func Remove13[A, B, C, D, E, F, G, H, I, J, K, L, M IComponent](world *World, entity EntityId) error {
+	return removeComponents(world, entity, []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+		ComponentIdFor[J](world),
+		ComponentIdFor[K](world),
+		ComponentIdFor[L](world),
+		ComponentIdFor[M](world),
+	})
+}
+
+// CommandsRemove13 records removing the given components from entity. See [Remove13].
+func CommandsRemove13[A, B, C, D, E, F, G, H, I, J, K, L, M IComponent](commands *Commands, entity EntityId) {
+	commands.add(func(world *World) error {
+		return Remove13[A, B, C, D, E, F, G, H, I, J, K, L, M](world, entity)
+	})
+}
+
+// Remove14 removes the given components from entity.
+//
+// Can return the following errors:
+//   - ErrEntityNotFound error if the entity does not exist in world.
+//   - ErrComponentNotFound error if the component is not present in the entity.
+func Remove14[A, B, C, D, E, F, G, H, I, J, K, L, M, N IComponent](world *World, entity EntityId) error {
+	return removeComponents(world, entity, []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+		ComponentIdFor[J](world),
+		ComponentIdFor[K](world),
+		ComponentIdFor[L](world),
+		ComponentIdFor[M](world),
+		ComponentIdFor[N](world),
+	})
+}
+
+// CommandsRemove14 records removing the given components from entity. See [Remove14].
+func CommandsRemove14[A, B, C, D, E, F, G, H, I, J, K, L, M, N IComponent](commands *Commands, entity EntityId) {
+	commands.add(func(world *World) error {
+		return Remove14[A, B, C, D, E, F, G, H, I, J, K, L, M, N](world, entity)
+	})
+}
+
+// Remove15 removes the given components from entity.
+//
+// Can return the following errors:
+//   - ErrEntityNotFound error if the entity does not exist in world.
+//   - ErrComponentNotFound error if the component is not present in the entity.
+func Remove15[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O IComponent](world *World, entity EntityId) error {
+	return removeComponents(world, entity, []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+		ComponentIdFor[J](world),
+		ComponentIdFor[K](world),
+		ComponentIdFor[L](world),
+		ComponentIdFor[M](world),
+		ComponentIdFor[N](world),
+		ComponentIdFor[O](world),
+	})
+}
+
+// CommandsRemove15 records removing the given components from entity. See [Remove15].
+func CommandsRemove15[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O IComponent](commands *Commands, entity EntityId) {
+	commands.add(func(world *World) error {
+		return Remove15[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O](world, entity)
+	})
+}
+
+// Remove16 removes the given components from entity.
+//
+// Can return the following errors:
+//   - ErrEntityNotFound error if the entity does not exist in world.
+//   - ErrComponentNotFound error if the component is not present in the entity.
+func Remove16[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P IComponent](world *World, entity EntityId) error {
+	return removeComponents(world, entity, []ComponentId{
+		ComponentIdFor[A](world),
+		ComponentIdFor[B](world),
+		ComponentIdFor[C](world),
+		ComponentIdFor[D](world),
+		ComponentIdFor[E](world),
+		ComponentIdFor[F](world),
+		ComponentIdFor[G](world),
+		ComponentIdFor[H](world),
+		ComponentIdFor[I](world),
+		ComponentIdFor[J](world),
+		ComponentIdFor[K](world),
+		ComponentIdFor[L](world),
+		ComponentIdFor[M](world),
+		ComponentIdFor[N](world),
+		ComponentIdFor[O](world),
+		ComponentIdFor[P](world),
+	})
+}
+
+// CommandsRemove16 records removing the given components from entity. See [Remove16].
+func CommandsRemove16[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P IComponent](commands *Commands, entity EntityId) {
+	commands.add(func(world *World) error {
+		return Remove16[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P](world, entity)
+	})
+}
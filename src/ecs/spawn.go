@@ -30,15 +30,14 @@ func Spawn(world *World, components ...IComponent) (EntityId, error) {
 	components = append(components, requiredComponents...)
 
 	// spawn components
-	entityId := world.generateEntityId()
-
 	var returnedErr error = nil
 
 	archetype, err := world.archetypeStorage.getArchetype(world, componentIds)
 	if err != nil {
 		return nonExistingEntity, err
 	}
-	world.archetypeStorage.entityIdToArchetype[entityId] = archetype
+
+	entityId := world.generateEntityId()
 	archetype.entities = append(archetype.entities, entityId)
 
 	var row uint
@@ -54,10 +53,8 @@ func Spawn(world *World, components ...IComponent) (EntityId, error) {
 		}
 	}
 
-	world.entities[entityId] = &EntityData{
-		row:       row,
-		archetype: archetype,
-	}
+	world.spawnEntity(entityId, archetype, row)
+	world.triggerObservers(observerEventAdd, entityId, componentIds)
 
 	return entityId, returnedErr
 }
@@ -15,22 +15,22 @@ func TestSpawn(t *testing.T) {
 
 		entity, err := Spawn(&world)
 		assert.Nil(t, err)
-		assert.Equal(t, entity, EntityId(1))
+		assert.Equal(t, entity, EntityId{index: 1})
 		entity, err = Spawn(&world, &componentA{})
 		assert.Nil(t, err)
-		assert.Equal(t, entity, EntityId(2))
+		assert.Equal(t, entity, EntityId{index: 2})
 		entity, err = Spawn(&world, &componentA{})
 		assert.Nil(t, err)
-		assert.Equal(t, entity, EntityId(3))
+		assert.Equal(t, entity, EntityId{index: 3})
 		entity, err = Spawn(&world, &componentB{})
 		assert.Nil(t, err)
-		assert.Equal(t, entity, EntityId(4))
+		assert.Equal(t, entity, EntityId{index: 4})
 		entity, err = Spawn(&world, &componentA{}, &componentB{})
 		assert.Nil(t, err)
-		assert.Equal(t, entity, EntityId(5))
+		assert.Equal(t, entity, EntityId{index: 5})
 		entity, err = Spawn(&world, &componentB{}, &componentA{})
 		assert.Nil(t, err)
-		assert.Equal(t, entity, EntityId(6))
+		assert.Equal(t, entity, EntityId{index: 6})
 
 		assert.Equal(t, 6, world.CountEntities())
 		assert.Equal(t, 7, world.CountComponents())
@@ -3,6 +3,7 @@ package ecs
 import (
 	"errors"
 	"reflect"
+	"sync/atomic"
 )
 
 type WorldId int
@@ -11,10 +12,14 @@ type WorldId int
 type World struct {
 	id *WorldId // setting an id is optional
 
-	entityIdCounter   uint
-	entities          map[EntityId]*EntityData
+	entities          []EntityData // entity table where the index of an EntityId is the index in to this slice
+	deadEntityPool    []uint       // indices of deleted entities that can be reused
+	numberOfEntities  int
 	componentRegistry componentRegistry
 	archetypeStorage  archetypeStorage
+	observers         observerStorage
+	changeTick        uint64     // tick that is used to mark components as added or changed, must be accessed atomically
+	lock              *worldLock // see LockWorlds
 
 	initialComponentCapacityStrategy initialComponentCapacityStrategy
 	componentCapacityGrowthStrategy  componentCapacityGrowthStrategy
@@ -44,7 +49,8 @@ func NewWorld(configs WorldConfigs) (World, error) {
 	}
 
 	return World{
-		entities:                         map[EntityId]*EntityData{},
+		// index 0 is reserved so that nonExistingEntity never points to a living entity
+		entities:                         []EntityData{{}},
 		id:                               configs.Id,
 		initialComponentCapacityStrategy: configs.InitialComponentCapacityStrategy,
 		componentCapacityGrowthStrategy:  configs.ComponentCapacityGrowthStrategy,
@@ -52,11 +58,25 @@ func NewWorld(configs WorldConfigs) (World, error) {
 			components: map[reflect.Type]uint{},
 		},
 		archetypeStorage: newArchetypeStorage(),
+		observers:        newObserverStorage(),
+		changeTick:       1, // start at 1 so that queries that never ran, see all components as added and changed
+		lock:             newWorldLock(),
 	}, nil
 }
 
+// currentChangeTick returns the tick that is used to mark components as added or changed.
+func (world *World) currentChangeTick() uint {
+	return uint(atomic.LoadUint64(&world.changeTick))
+}
+
+// advanceChangeTick returns the current change tick and increments it. It is atomic so that read-only queries of
+// different goroutines can run on the same world at the same time.
+func (world *World) advanceChangeTick() uint {
+	return uint(atomic.AddUint64(&world.changeTick, 1) - 1)
+}
+
 func (world *World) CountEntities() int {
-	return len(world.entities)
+	return world.numberOfEntities
 }
 
 func (world *World) CountComponents() int {
@@ -67,9 +87,66 @@ func (world *World) CountArchetypes() int {
 	return len(world.archetypeStorage.componentsHashToArchetype)
 }
 
+// generateEntityId returns a new EntityId, reusing the index of a deleted entity if there is one.
+// The returned entity is not yet alive, use spawnEntity to make it so.
 func (world *World) generateEntityId() EntityId {
-	world.entityIdCounter++
-	return EntityId(world.entityIdCounter)
+	numberOfDeadEntities := len(world.deadEntityPool)
+	if numberOfDeadEntities > 0 {
+		index := world.deadEntityPool[numberOfDeadEntities-1]
+		world.deadEntityPool = world.deadEntityPool[:numberOfDeadEntities-1]
+
+		return EntityId{
+			index:      index,
+			generation: world.entities[index].generation,
+		}
+	}
+
+	world.entities = append(world.entities, EntityData{})
+	return EntityId{index: uint(len(world.entities) - 1)}
+}
+
+// spawnEntity marks entity, that was generated with generateEntityId, as alive.
+func (world *World) spawnEntity(entity EntityId, archetype *Archetype, row uint) {
+	world.entities[entity.index] = EntityData{
+		archetype:  archetype,
+		row:        row,
+		generation: entity.generation,
+		isAlive:    true,
+	}
+	world.numberOfEntities++
+}
+
+// despawnEntity marks entity as not alive and makes its index available for reuse with an incremented generation.
+func (world *World) despawnEntity(entity EntityId) {
+	world.entities[entity.index] = EntityData{
+		generation: entity.generation + 1,
+	}
+	world.deadEntityPool = append(world.deadEntityPool, entity.index)
+	world.numberOfEntities--
+}
+
+// getEntityData returns the data of entity. Returns false if entity does not exist, which is also the case
+// if entity has been deleted and its index has been reused for another entity.
+//
+// The returned pointer is only valid until the next entity gets spawned.
+func (world *World) getEntityData(entity EntityId) (*EntityData, bool) {
+	if entity.index >= uint(len(world.entities)) {
+		return nil, false
+	}
+
+	entityData := &world.entities[entity.index]
+	if !entityData.isAlive || entityData.generation != entity.generation {
+		return nil, false
+	}
+
+	return entityData, true
+}
+
+// IsAlive returns wether entity exists in the world. Returns false if entity has been deleted, even if a newer
+// entity now uses the same index.
+func (world *World) IsAlive(entity EntityId) bool {
+	_, isAlive := world.getEntityData(entity)
+	return isAlive
 }
 
 func (world *World) Id() *WorldId {
@@ -0,0 +1,81 @@
+package ecs
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// worldLock is used to synchronize access to a world between goroutines, such as between apps that query each
+// others world.
+type worldLock struct {
+	sync.RWMutex
+	order uint64 // worlds are locked in the order of this number, to prevent deadlocks
+}
+
+var nextWorldLockOrder atomic.Uint64
+
+func newWorldLock() *worldLock {
+	return &worldLock{order: nextWorldLockOrder.Add(1)}
+}
+
+// LockWorlds locks writeWorlds for writing and readWorlds for reading, and returns a function that unlocks them
+// again. A world that is given as both a write world and a read world is only locked for writing.
+//
+// Worlds are always locked in the same order, so that goroutines that lock overlapping worlds can not deadlock, as
+// long as they lock all worlds that they need with a single call to LockWorlds.
+//
+// The ecs functions do not lock a world themselves. Worlds that are not created by NewWorld do not have a lock
+// and are skipped.
+func LockWorlds(writeWorlds []*World, readWorlds []*World) (unlock func()) {
+	type lockEntry struct {
+		lock  *worldLock
+		write bool
+	}
+
+	entries := []lockEntry{}
+	add := func(world *World, write bool) {
+		if world == nil || world.lock == nil {
+			return
+		}
+
+		for i := range entries {
+			if entries[i].lock == world.lock {
+				entries[i].write = entries[i].write || write
+				return
+			}
+		}
+
+		entries = append(entries, lockEntry{lock: world.lock, write: write})
+	}
+
+	for _, world := range writeWorlds {
+		add(world, true)
+	}
+	for _, world := range readWorlds {
+		add(world, false)
+	}
+
+	slices.SortFunc(entries, func(a, b lockEntry) int {
+		return cmp.Compare(a.lock.order, b.lock.order)
+	})
+
+	for _, entry := range entries {
+		if entry.write {
+			entry.lock.Lock()
+		} else {
+			entry.lock.RLock()
+		}
+	}
+
+	return func() {
+		for i := len(entries) - 1; i >= 0; i-- {
+			if entries[i].write {
+				entries[i].lock.Unlock()
+			} else {
+				entries[i].lock.RUnlock()
+			}
+		}
+	}
+}
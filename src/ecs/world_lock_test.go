@@ -0,0 +1,75 @@
+package ecs
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockWorlds(t *testing.T) {
+	t.Run("locks write worlds for writing and read worlds for reading", func(t *testing.T) {
+		assert := assert.New(t)
+
+		worldA := NewDefaultWorld()
+		worldB := NewDefaultWorld()
+
+		unlock := LockWorlds([]*World{&worldA}, []*World{&worldB})
+		assert.False(worldA.lock.TryRLock())
+		assert.True(worldB.lock.TryRLock())
+		worldB.lock.RUnlock()
+		assert.False(worldB.lock.TryLock())
+
+		unlock()
+		assert.True(worldA.lock.TryLock())
+		worldA.lock.Unlock()
+		assert.True(worldB.lock.TryLock())
+		worldB.lock.Unlock()
+	})
+
+	t.Run("locks a world that is both written and read only for writing", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+
+		unlock := LockWorlds([]*World{&world}, []*World{&world, &world})
+		assert.False(world.lock.TryRLock())
+
+		unlock()
+		assert.True(world.lock.TryLock())
+		world.lock.Unlock()
+	})
+
+	t.Run("skips worlds without a lock", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := World{}
+		assert.NotPanics(func() {
+			unlock := LockWorlds([]*World{&world, nil}, []*World{&world})
+			unlock()
+		})
+	})
+
+	t.Run("does not deadlock when locking the same worlds in a different order", func(t *testing.T) {
+		worldA := NewDefaultWorld()
+		worldB := NewDefaultWorld()
+
+		var waitGroup sync.WaitGroup
+		for i := range 100 {
+			waitGroup.Add(1)
+			go func() {
+				defer waitGroup.Done()
+
+				var unlock func()
+				if i%2 == 0 {
+					unlock = LockWorlds([]*World{&worldA}, []*World{&worldB})
+				} else {
+					unlock = LockWorlds([]*World{&worldB}, []*World{&worldA})
+				}
+				unlock()
+			}()
+		}
+
+		waitGroup.Wait()
+	})
+}
@@ -63,6 +63,67 @@ func TestGenerateEntityId(t *testing.T) {
 	assert.NotEqual(entity1, entity2)
 }
 
+func TestEntityGenerations(t *testing.T) {
+	type componentA struct{ Component }
+
+	t.Run("reuses the index of a deleted entity with a new generation", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		deletedEntity, err := Spawn(&world, &componentA{})
+		assert.NoError(err)
+		err = Delete(&world, deletedEntity)
+		assert.NoError(err)
+
+		entity, err := Spawn(&world, &componentA{})
+		assert.NoError(err)
+		assert.Equal(deletedEntity.Index(), entity.Index())
+		assert.Equal(deletedEntity.Generation()+1, entity.Generation())
+		assert.NotEqual(deletedEntity, entity)
+		assert.True(world.IsAlive(entity))
+		assert.False(world.IsAlive(deletedEntity))
+	})
+
+	t.Run("returns ErrEntityNotFound for an entity with a stale generation", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		staleEntity, err := Spawn(&world, &componentA{})
+		assert.NoError(err)
+		err = Delete(&world, staleEntity)
+		assert.NoError(err)
+		_, err = Spawn(&world, &componentA{})
+		assert.NoError(err)
+
+		_, err = Get1[componentA](&world, staleEntity)
+		assert.ErrorIs(err, ErrEntityNotFound)
+		_, err = HasComponent[componentA](&world, staleEntity)
+		assert.ErrorIs(err, ErrEntityNotFound)
+		err = Insert(&world, staleEntity, &emptyComponentA{})
+		assert.ErrorIs(err, ErrEntityNotFound)
+		err = Remove1[componentA](&world, staleEntity)
+		assert.ErrorIs(err, ErrEntityNotFound)
+		err = Delete(&world, staleEntity)
+		assert.ErrorIs(err, ErrEntityNotFound)
+		assert.Equal(1, world.CountEntities())
+	})
+
+	t.Run("does not grow the entity table when spawning and deleting in a loop", func(t *testing.T) {
+		assert := assert.New(t)
+
+		world := NewDefaultWorld()
+		for range 100 {
+			entity, err := Spawn(&world, &componentA{})
+			assert.NoError(err)
+			err = Delete(&world, entity)
+			assert.NoError(err)
+		}
+
+		assert.Equal(2, len(world.entities))
+		assert.Equal(0, world.CountEntities())
+	})
+}
+
 func TestStats(t *testing.T) {
 	t.Run("world returns the correct stats after inserting", func(t *testing.T) {
 		assert := assert.New(t)
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lucdrenth/murphecs/src/app"
+	"github.com/lucdrenth/murphecs/src/ecs"
+)
+
+// The setup of the query_between_apps example, but with multiple SubApps that query the world of the same SubApp
+// as fast as possible while that SubApp changes it. Run it with -race:
+//
+//	go test -race ./stress_test
+type outerComponent struct {
+	ecs.Component
+	value int
+}
+
+type otherOuterComponent struct{ ecs.Component }
+
+var outerWorldId = ecs.WorldId(10)
+
+type targetOuterWorld struct{}
+
+func (targetOuterWorld) GetWorldId() *ecs.WorldId {
+	return &outerWorldId
+}
+
+type readOuterWorld = ecs.QueryOptions[ecs.NoFilter, ecs.NoOptional, ecs.AllReadOnly, ecs.NotLazy, targetOuterWorld]
+type mutateOuterWorld = ecs.QueryOptions[ecs.NoFilter, ecs.NoOptional, ecs.NoReadOnly, ecs.NotLazy, targetOuterWorld]
+
+func TestOuterWorldQueriesWithMultipleReaders(t *testing.T) {
+	const numberOfReaders = 4
+	const numberOfMutators = 2
+
+	outerConfigs := ecs.DefaultWorldConfigs()
+	outerConfigs.Id = &outerWorldId
+	outer, err := app.New(nil, outerConfigs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	outer.SetTickRate(time.Microsecond)
+	outer.AddSchedule(update, app.ScheduleTypeRepeating)
+
+	myApp := app.NewApp(nil)
+	myApp.AddSubApp("Outer", &outer)
+
+	// Component ids are registered per world, so outerComponent must get the same id in the outer world as in the
+	// worlds of the SubApps that query it.
+	ecs.ComponentIdFor[outerComponent](outer.World())
+
+	// spawn, change and delete entities, which moves components around in their storages
+	tick := 0
+	outer.AddSystem(update, func(commands *app.Commands, query *ecs.Query1[outerComponent, ecs.Without[otherOuterComponent]]) error {
+		tick++
+
+		err := query.Result().Iter(func(entityId ecs.EntityId, component *outerComponent) error {
+			component.value++
+			if component.value%7 == 0 {
+				commands.Delete(entityId)
+			} else if component.value%3 == 0 {
+				commands.Insert(entityId, &otherOuterComponent{})
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for range 5 {
+			commands.Spawn(&outerComponent{value: tick})
+		}
+
+		return nil
+	})
+
+	// counters are kept per SubApp, because synchronizing the SubApps through a shared counter would hide races
+	reads := make([]int, numberOfReaders)
+	invalidReads := make([]int, numberOfReaders)
+	for i := range numberOfReaders {
+		reader, err := app.New(nil, ecs.DefaultWorldConfigs())
+		if err != nil {
+			t.Fatal(err)
+		}
+		reader.SetTickRate(time.Microsecond)
+		reader.AddSchedule(update, app.ScheduleTypeRepeating)
+		myApp.AddSubApp(fmt.Sprintf("Reader%d", i), &reader)
+
+		reader.AddSystem(update, func(query *ecs.Query1[outerComponent, readOuterWorld]) {
+			for component := range query.Result().Range() {
+				if component.value < 1 {
+					invalidReads[i]++
+				}
+			}
+			reads[i]++
+		})
+	}
+
+	mutations := make([]int, numberOfMutators)
+	for i := range numberOfMutators {
+		mutator, err := app.New(nil, ecs.DefaultWorldConfigs())
+		if err != nil {
+			t.Fatal(err)
+		}
+		mutator.SetTickRate(time.Microsecond)
+		mutator.AddSchedule(update, app.ScheduleTypeRepeating)
+		myApp.AddSubApp(fmt.Sprintf("Mutator%d", i), &mutator)
+
+		mutator.AddSystem(update, func(query *ecs.Query1[outerComponent, mutateOuterWorld]) {
+			for component := range query.Result().Range() {
+				component.value += 2
+			}
+			mutations[i]++
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	if err := myApp.RunContext(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range numberOfReaders {
+		if reads[i] == 0 {
+			t.Errorf("reader %d did not query the outer world", i)
+		}
+		if invalidReads[i] > 0 {
+			t.Errorf("reader %d read %d components with an invalid value", i, invalidReads[i])
+		}
+	}
+	for i := range numberOfMutators {
+		if mutations[i] == 0 {
+			t.Errorf("mutator %d did not query the outer world", i)
+		}
+	}
+}